@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringAttr converts a scalar field of an API response to a string attribute, null when it is not set.
+func stringAttr(v interface{}) types.String {
+	switch v := v.(type) {
+	case nil:
+		return types.StringNull()
+	case string:
+		return types.StringValue(v)
+	default:
+		return types.StringValue(fmt.Sprint(v))
+	}
+}
+
+// int64Attr converts a number field of an API response to an int64 attribute, null when it is not set.
+func int64Attr(v interface{}) types.Int64 {
+	if n, ok := v.(float64); ok {
+		return types.Int64Value(int64(n))
+	}
+	return types.Int64Null()
+}
+
+// boolAttr converts a boolean field of an API response to a bool attribute, null when it is not set.
+func boolAttr(v interface{}) types.Bool {
+	if b, ok := v.(bool); ok {
+		return types.BoolValue(b)
+	}
+	return types.BoolNull()
+}
+
+// float64Attr converts a number field of an API response to a float64 attribute, null when it is not set.
+func float64Attr(v interface{}) types.Float64 {
+	if n, ok := v.(float64); ok {
+		return types.Float64Value(n)
+	}
+	return types.Float64Null()
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EndpointsDataSource{}
+
+func NewEndpointsDataSource() datasource.DataSource {
+	return &EndpointsDataSource{}
+}
+
+// EndpointsDataSource defines the data source implementation.
+type EndpointsDataSource struct {
+	client *Client
+}
+
+// EndpointsDataSourceModel describes the data source data model.
+type EndpointsDataSourceModel struct {
+	Namespace types.String    `tfsdk:"namespace"`
+	Pipeline  types.String    `tfsdk:"pipeline"`
+	Workload  types.String    `tfsdk:"workload"`
+	URLs      []string        `tfsdk:"urls"`
+	Endpoints []endpointModel `tfsdk:"endpoints"`
+	Id        types.String    `tfsdk:"id"`
+}
+
+type endpointModel struct {
+	URL      types.String `tfsdk:"url"`
+	Private  types.Bool   `tfsdk:"private"`
+	Divert   types.Bool   `tfsdk:"divert"`
+	Kind     types.String `tfsdk:"kind"`
+	Workload types.String `tfsdk:"workload"`
+}
+
+func (d *EndpointsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoints"
+}
+
+func (d *EndpointsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Endpoints published by the deployments, development containers, functions, statefulsets and external resources of a namespace",
+
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace. Defaults to the provider namespace.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"pipeline": schema.StringAttribute{
+				MarkdownDescription: "Only return the endpoints of the workloads deployed by this pipeline",
+				Optional:            true,
+			},
+			"workload": schema.StringAttribute{
+				MarkdownDescription: "Only return the endpoints of the workloads with this name",
+				Optional:            true,
+			},
+			"urls": schema.ListAttribute{
+				MarkdownDescription: "Endpoint URLs",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"endpoints": schema.ListNestedAttribute{
+				MarkdownDescription: "Endpoints",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							MarkdownDescription: "URL",
+							Computed:            true,
+						},
+						"private": schema.BoolAttribute{
+							MarkdownDescription: "Whether the endpoint is only reachable by the members of the namespace",
+							Computed:            true,
+						},
+						"divert": schema.BoolAttribute{
+							MarkdownDescription: "Whether the endpoint is a divert endpoint",
+							Computed:            true,
+						},
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Kind of the workload publishing the endpoint, one of `deployments`, `devs`, `functions`, `statefulsets` or `externals`",
+							Computed:            true,
+						},
+						"workload": schema.StringAttribute{
+							MarkdownDescription: "Name of the workload publishing the endpoint",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *EndpointsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EndpointsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EndpointsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace := d.client.Namespace
+	if !data.Namespace.IsNull() {
+		namespace = data.Namespace.ValueString()
+	}
+	deployedBy := ""
+	if !data.Pipeline.IsNull() {
+		deployedBy = PipelineDeployedBy(data.Pipeline.ValueString())
+	}
+
+	workloads, err := d.client.ListWorkloads(namespace, EndpointKinds, deployedBy)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read endpoints, got error: %s", err))
+		return
+	}
+
+	data.Namespace = types.StringValue(namespace)
+	data.Endpoints = flattenEndpoints(workloads, data.Workload.ValueString())
+	data.URLs = []string{}
+	for _, endpoint := range data.Endpoints {
+		data.URLs = append(data.URLs, endpoint.URL.ValueString())
+	}
+	data.Id = types.StringValue(namespace)
+	tflog.Trace(ctx, "read endpoints")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenEndpoints returns the endpoints of the workloads, keyed by kind, in the order of EndpointKinds.
+// Only the endpoints of the workloads named workloadName are returned, unless it is empty.
+func flattenEndpoints(workloads map[string][]map[string]interface{}, workloadName string) []endpointModel {
+	endpoints := []endpointModel{}
+	for _, kind := range EndpointKinds {
+		for _, workload := range workloads[kind] {
+			name, _ := workload["name"].(string)
+			if workloadName != "" && name != workloadName {
+				continue
+			}
+			workloadEndpoints, _ := workload["endpoints"].([]interface{})
+			for _, e := range workloadEndpoints {
+				endpoint, _ := e.(map[string]interface{})
+				url, _ := endpoint["url"].(string)
+				if url == "" {
+					continue
+				}
+				private, _ := endpoint["private"].(bool)
+				divert, _ := endpoint["divert"].(bool)
+				endpoints = append(endpoints, endpointModel{
+					URL:      types.StringValue(url),
+					Private:  types.BoolValue(private),
+					Divert:   types.BoolValue(divert),
+					Kind:     types.StringValue(kind),
+					Workload: types.StringValue(name),
+				})
+			}
+		}
+	}
+	return endpoints
+}
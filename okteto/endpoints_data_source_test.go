@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEndpointsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccEndpointsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okteto_endpoints.test", "namespace", "skyscrapr"),
+					resource.TestCheckResourceAttrSet("data.okteto_endpoints.test", "urls.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEndpointsDataSourceConfig = `
+provider okteto {
+	namespace = "skyscrapr"
+}
+
+resource "okteto_pipeline" "test" {
+  name = "okteto_aws_lambda"
+  repo_url = "https://github.com/skyscrapr/okteto-pipeline-test.git"
+  branch = "main"
+}
+
+data "okteto_endpoints" "test" {
+  pipeline = okteto_pipeline.test.name
+}
+`
+
+func TestFlattenEndpoints(t *testing.T) {
+	workloads := map[string][]map[string]interface{}{
+		"deployments": {
+			{"name": "api", "endpoints": []interface{}{
+				map[string]interface{}{"url": "https://api-ns.okteto.example.com", "private": true, "divert": false},
+			}},
+			{"name": "worker"},
+		},
+		"externals": {
+			{"name": "lambda", "endpoints": []interface{}{
+				map[string]interface{}{"url": "https://lambda.aws.example.com"},
+			}},
+		},
+	}
+
+	endpoints := flattenEndpoints(workloads, "")
+	if len(endpoints) != 2 {
+		t.Fatalf("flattenEndpoints() returned %d endpoints, want 2", len(endpoints))
+	}
+	if got := endpoints[0]; got.Kind.ValueString() != "deployments" || got.Workload.ValueString() != "api" || !got.Private.ValueBool() {
+		t.Errorf("flattenEndpoints()[0] = %+v, want private deployments endpoint of api", got)
+	}
+	if got := endpoints[1]; got.Kind.ValueString() != "externals" || got.URL.ValueString() != "https://lambda.aws.example.com" || got.Private.ValueBool() {
+		t.Errorf("flattenEndpoints()[1] = %+v, want public externals endpoint of lambda", got)
+	}
+
+	endpoints = flattenEndpoints(workloads, "lambda")
+	if len(endpoints) != 1 || endpoints[0].Workload.ValueString() != "lambda" {
+		t.Errorf("flattenEndpoints() filtered by workload = %+v, want the lambda endpoint", endpoints)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ExternalResourcesDataSource{}
+
+func NewExternalResourcesDataSource() datasource.DataSource {
+	return &ExternalResourcesDataSource{}
+}
+
+// ExternalResourcesDataSource defines the data source implementation.
+type ExternalResourcesDataSource struct {
+	client *Client
+}
+
+// ExternalResourcesDataSourceModel describes the data source data model.
+type ExternalResourcesDataSourceModel struct {
+	Namespace types.String `tfsdk:"namespace"`
+	Pipeline  types.String `tfsdk:"pipeline"`
+	Externals types.List   `tfsdk:"externals"`
+	Id        types.String `tfsdk:"id"`
+}
+
+func (d *ExternalResourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_resources"
+}
+
+func (d *ExternalResourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "External resources, such as cloud functions or buckets, declared by the manifests of the pipelines of a namespace",
+
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace. Defaults to the provider namespace.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"pipeline": schema.StringAttribute{
+				MarkdownDescription: "Only return the external resources declared by this pipeline",
+				Optional:            true,
+			},
+			"externals": schema.ListNestedAttribute{
+				MarkdownDescription: "External resources",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name",
+							Computed:            true,
+						},
+						"icon": schema.StringAttribute{
+							MarkdownDescription: "Icon",
+							Computed:            true,
+						},
+						"endpoints": schema.ListAttribute{
+							MarkdownDescription: "Endpoint URLs",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"notes": schema.ListNestedAttribute{
+							MarkdownDescription: "Notes",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"path": schema.StringAttribute{
+										MarkdownDescription: "Path of the notes file in the repository",
+										Computed:            true,
+									},
+									"markdown": schema.StringAttribute{
+										MarkdownDescription: "Notes, in Markdown",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ExternalResourcesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ExternalResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ExternalResourcesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace := d.client.Namespace
+	if !data.Namespace.IsNull() {
+		namespace = data.Namespace.ValueString()
+	}
+	deployedBy := ""
+	if !data.Pipeline.IsNull() {
+		deployedBy = PipelineDeployedBy(data.Pipeline.ValueString())
+	}
+
+	workloads, err := d.client.ListWorkloads(namespace, []string{"externals"}, deployedBy)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read external resources, got error: %s", err))
+		return
+	}
+
+	data.Namespace = types.StringValue(namespace)
+	externals, diags := flattenExternals(ctx, workloads["externals"])
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Externals = externals
+	data.Id = types.StringValue(namespace)
+	tflog.Trace(ctx, "read external resources")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
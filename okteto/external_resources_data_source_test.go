@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccExternalResourcesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccExternalResourcesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okteto_external_resources.test", "namespace", "skyscrapr"),
+					resource.TestCheckResourceAttrPair("data.okteto_external_resources.test", "externals.#", "okteto_pipeline.test", "externals.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccExternalResourcesDataSourceConfig = `
+provider okteto {
+	namespace = "skyscrapr"
+}
+
+resource "okteto_pipeline" "test" {
+  name = "okteto_aws_lambda"
+  repo_url = "https://github.com/skyscrapr/okteto-pipeline-test.git"
+  branch = "main"
+}
+
+data "okteto_external_resources" "test" {
+  pipeline = okteto_pipeline.test.name
+}
+`
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NamespaceDataSource{}
+
+func NewNamespaceDataSource() datasource.DataSource {
+	return &NamespaceDataSource{}
+}
+
+// NamespaceDataSource defines the data source implementation.
+type NamespaceDataSource struct {
+	client *Client
+}
+
+// NamespaceDataSourceModel describes the data source data model.
+type NamespaceDataSourceModel struct {
+	Name       types.String           `tfsdk:"name"`
+	Status     types.String           `tfsdk:"status"`
+	Scope      types.String           `tfsdk:"scope"`
+	Persistent types.Bool             `tfsdk:"persistent"`
+	Members    []namespaceMemberModel `tfsdk:"members"`
+	Quotas     *namespaceQuotasModel  `tfsdk:"quotas"`
+	Id         types.String           `tfsdk:"id"`
+}
+
+type namespaceMemberModel struct {
+	Id    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+	Owner types.Bool   `tfsdk:"owner"`
+}
+
+type namespaceQuotasModel struct {
+	CPU     *namespaceQuotaModel `tfsdk:"cpu"`
+	Memory  *namespaceQuotaModel `tfsdk:"memory"`
+	Pods    *namespaceQuotaModel `tfsdk:"pods"`
+	Storage *namespaceQuotaModel `tfsdk:"storage"`
+}
+
+type namespaceQuotaModel struct {
+	Limits        types.Float64 `tfsdk:"limits"`
+	LimitsTotal   types.Float64 `tfsdk:"limits_total"`
+	Requests      types.Float64 `tfsdk:"requests"`
+	RequestsTotal types.Float64 `tfsdk:"requests_total"`
+	Total         types.Float64 `tfsdk:"total"`
+	Used          types.Float64 `tfsdk:"used"`
+	Available     types.Float64 `tfsdk:"available"`
+}
+
+func (d *NamespaceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace"
+}
+
+func (d *NamespaceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	quotaAttribute := func(description string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			MarkdownDescription: description,
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"limits": schema.Float64Attribute{
+					MarkdownDescription: "Limits of the workloads of the user",
+					Computed:            true,
+				},
+				"limits_total": schema.Float64Attribute{
+					MarkdownDescription: "Limits of every workload",
+					Computed:            true,
+				},
+				"requests": schema.Float64Attribute{
+					MarkdownDescription: "Requests of the workloads of the user",
+					Computed:            true,
+				},
+				"requests_total": schema.Float64Attribute{
+					MarkdownDescription: "Requests of every workload",
+					Computed:            true,
+				},
+				"total": schema.Float64Attribute{
+					MarkdownDescription: "Quota",
+					Computed:            true,
+				},
+				"used": schema.Float64Attribute{
+					MarkdownDescription: "Used",
+					Computed:            true,
+				},
+				"available": schema.Float64Attribute{
+					MarkdownDescription: "Available headroom, `total` minus `used`",
+					Computed:            true,
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Namespace status, members and quotas",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name. Defaults to the provider namespace.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status",
+				Computed:            true,
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Scope, such as `personal` or `shared`",
+				Computed:            true,
+			},
+			"persistent": schema.BoolAttribute{
+				MarkdownDescription: "Whether the namespace is never scaled to zero or deleted for inactivity",
+				Computed:            true,
+			},
+			"members": schema.ListNestedAttribute{
+				MarkdownDescription: "Members",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "User identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Email",
+							Computed:            true,
+						},
+						"owner": schema.BoolAttribute{
+							MarkdownDescription: "Whether the member owns the namespace",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"quotas": schema.SingleNestedAttribute{
+				MarkdownDescription: "Quotas",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"cpu":     quotaAttribute("CPU quota, in cores"),
+					"memory":  quotaAttribute("Memory quota, in bytes"),
+					"pods":    quotaAttribute("Pod quota"),
+					"storage": quotaAttribute("Storage quota, in bytes"),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Namespace identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *NamespaceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NamespaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NamespaceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := d.client.Namespace
+	if !data.Name.IsNull() {
+		name = data.Name.ValueString()
+	}
+
+	namespace, err := d.client.GetNamespace(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read namespace, got error: %s", err))
+		return
+	}
+
+	data.Name = types.StringValue(name)
+	data.Id = stringAttr(namespace["id"])
+	data.Status = stringAttr(namespace["status"])
+	data.Scope = stringAttr(namespace["scope"])
+	data.Persistent = boolAttr(namespace["persistent"])
+
+	members, err := listData(namespace, "members")
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read namespace members, got error: %s", err))
+		return
+	}
+	data.Members = []namespaceMemberModel{}
+	for _, member := range members {
+		data.Members = append(data.Members, namespaceMemberModel{
+			Id:    stringAttr(member["id"]),
+			Name:  stringAttr(member["name"]),
+			Email: stringAttr(member["email"]),
+			Owner: boolAttr(member["owner"]),
+		})
+	}
+
+	data.Quotas = nil
+	if quotas, ok := namespace["quotas"].(map[string]interface{}); ok {
+		data.Quotas = &namespaceQuotasModel{
+			CPU:     flattenQuota(quotas["cpu"]),
+			Memory:  flattenQuota(quotas["memory"]),
+			Pods:    flattenQuota(quotas["pods"]),
+			Storage: flattenQuota(quotas["storage"]),
+		}
+	}
+	tflog.Trace(ctx, "read namespace")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenQuota converts a quota of the namespace, adding the headroom left.
+func flattenQuota(v interface{}) *namespaceQuotaModel {
+	quota, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	data := &namespaceQuotaModel{
+		Limits:        float64Attr(quota["limits"]),
+		LimitsTotal:   float64Attr(quota["limitsTotal"]),
+		Requests:      float64Attr(quota["requests"]),
+		RequestsTotal: float64Attr(quota["requestsTotal"]),
+		Total:         float64Attr(quota["total"]),
+		Used:          float64Attr(quota["used"]),
+		Available:     types.Float64Null(),
+	}
+	if !data.Total.IsNull() && !data.Used.IsNull() {
+		data.Available = types.Float64Value(data.Total.ValueFloat64() - data.Used.ValueFloat64())
+	}
+	return data
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNamespaceDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccNamespaceDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okteto_namespace.test", "name", "skyscrapr"),
+					resource.TestCheckResourceAttrSet("data.okteto_namespace.test", "id"),
+					resource.TestCheckResourceAttrSet("data.okteto_namespace.test", "status"),
+					resource.TestCheckResourceAttrSet("data.okteto_namespace.test", "members.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNamespaceDataSourceConfig = `
+provider okteto {
+	namespace = "skyscrapr"
+}
+
+data "okteto_namespace" "test" {}
+`
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NamespacesDataSource{}
+
+func NewNamespacesDataSource() datasource.DataSource {
+	return &NamespacesDataSource{}
+}
+
+// NamespacesDataSource defines the data source implementation.
+type NamespacesDataSource struct {
+	client *Client
+}
+
+// NamespacesDataSourceModel describes the data source data model.
+type NamespacesDataSourceModel struct {
+	Scope      types.String               `tfsdk:"scope"`
+	Status     types.String               `tfsdk:"status"`
+	NameRegex  types.String               `tfsdk:"name_regex"`
+	Owner      types.String               `tfsdk:"owner"`
+	Names      []types.String             `tfsdk:"names"`
+	Namespaces []namespacesNamespaceModel `tfsdk:"namespaces"`
+	Id         types.String               `tfsdk:"id"`
+}
+
+type namespacesNamespaceModel struct {
+	Name       types.String `tfsdk:"name"`
+	Status     types.String `tfsdk:"status"`
+	Scope      types.String `tfsdk:"scope"`
+	Persistent types.Bool   `tfsdk:"persistent"`
+	Owners     []string     `tfsdk:"owners"`
+}
+
+// namespaceFilter selects namespaces. Empty fields match every namespace.
+type namespaceFilter struct {
+	scope     string
+	status    string
+	nameRegex *regexp.Regexp
+	owner     string
+}
+
+func (d *NamespacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespaces"
+}
+
+func (d *NamespacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Personal and shared namespaces the provider API token can access",
+
+		Attributes: map[string]schema.Attribute{
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Only return namespaces with this scope, such as `personal` or `shared`",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return namespaces with this status",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return namespaces whose name matches this regular expression",
+				Optional:            true,
+				Validators: []validator.String{
+					regexValidator{},
+				},
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "Only return namespaces owned by the member with this identifier, name or email",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "Names of the namespaces",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"namespaces": schema.ListNestedAttribute{
+				MarkdownDescription: "Namespaces",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status",
+							Computed:            true,
+						},
+						"scope": schema.StringAttribute{
+							MarkdownDescription: "Scope",
+							Computed:            true,
+						},
+						"persistent": schema.BoolAttribute{
+							MarkdownDescription: "Whether the namespace is never scaled to zero or deleted for inactivity",
+							Computed:            true,
+						},
+						"owners": schema.ListAttribute{
+							MarkdownDescription: "Emails of the owners, or their names when the email is not known",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *NamespacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NamespacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NamespacesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := namespaceFilter{
+		scope:  data.Scope.ValueString(),
+		status: data.Status.ValueString(),
+		owner:  data.Owner.ValueString(),
+	}
+	if !data.NameRegex.IsNull() {
+		// The expression is checked by regexValidator
+		filter.nameRegex = regexp.MustCompile(data.NameRegex.ValueString())
+	}
+
+	namespaces, err := d.client.ListNamespaces()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read namespaces, got error: %s", err))
+		return
+	}
+
+	data.Names = []types.String{}
+	data.Namespaces = []namespacesNamespaceModel{}
+	for _, namespace := range namespaces {
+		if !filter.matches(namespace) {
+			continue
+		}
+		data.Names = append(data.Names, stringAttr(namespace["id"]))
+		data.Namespaces = append(data.Namespaces, namespacesNamespaceModel{
+			Name:       stringAttr(namespace["id"]),
+			Status:     stringAttr(namespace["status"]),
+			Scope:      stringAttr(namespace["scope"]),
+			Persistent: boolAttr(namespace["persistent"]),
+			Owners:     namespaceOwners(namespace),
+		})
+	}
+	data.Id = types.StringValue("namespaces")
+	tflog.Trace(ctx, "read namespaces")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// matches reports whether the namespace is selected by the filter.
+func (f namespaceFilter) matches(namespace map[string]interface{}) bool {
+	name, _ := namespace["id"].(string)
+	scope, _ := namespace["scope"].(string)
+	status, _ := namespace["status"].(string)
+	if f.scope != "" && scope != f.scope {
+		return false
+	}
+	if f.status != "" && status != f.status {
+		return false
+	}
+	if f.nameRegex != nil && !f.nameRegex.MatchString(name) {
+		return false
+	}
+	if f.owner == "" {
+		return true
+	}
+	members, _ := listData(namespace, "members")
+	for _, member := range members {
+		if owner, _ := member["owner"].(bool); !owner {
+			continue
+		}
+		for _, field := range []string{"id", "name", "email"} {
+			if value, _ := member[field].(string); value == f.owner {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// namespaceOwners returns the emails of the owners of the namespace, or their names when the email is not known.
+func namespaceOwners(namespace map[string]interface{}) []string {
+	owners := []string{}
+	members, _ := listData(namespace, "members")
+	for _, member := range members {
+		if owner, _ := member["owner"].(bool); !owner {
+			continue
+		}
+		email, _ := member["email"].(string)
+		if email == "" {
+			email, _ = member["name"].(string)
+		}
+		owners = append(owners, email)
+	}
+	return owners
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNamespacesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccNamespacesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okteto_namespaces.test", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.okteto_namespaces.test", "names.0", "skyscrapr"),
+					resource.TestCheckResourceAttr("data.okteto_namespaces.test", "namespaces.0.name", "skyscrapr"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNamespacesDataSourceConfig = `
+provider okteto {
+	namespace = "skyscrapr"
+}
+
+data "okteto_namespaces" "test" {
+  name_regex = "^skyscrapr$"
+}
+`
+
+func TestNamespaceFilter(t *testing.T) {
+	namespace := map[string]interface{}{
+		"id":     "preview-42",
+		"scope":  "preview",
+		"status": "Active",
+		"members": []interface{}{
+			map[string]interface{}{"id": "1", "name": "cindy", "email": "cindy@example.com", "owner": true},
+			map[string]interface{}{"id": "2", "name": "ramon", "email": "ramon@example.com", "owner": false},
+		},
+	}
+	tests := []struct {
+		name   string
+		filter namespaceFilter
+		want   bool
+	}{
+		{name: "no filter", filter: namespaceFilter{}, want: true},
+		{name: "scope", filter: namespaceFilter{scope: "preview"}, want: true},
+		{name: "other scope", filter: namespaceFilter{scope: "personal"}, want: false},
+		{name: "status", filter: namespaceFilter{status: "Sleeping"}, want: false},
+		{name: "name regex", filter: namespaceFilter{nameRegex: regexp.MustCompile("^preview-")}, want: true},
+		{name: "other name regex", filter: namespaceFilter{nameRegex: regexp.MustCompile("^dev-")}, want: false},
+		{name: "owner email", filter: namespaceFilter{owner: "cindy@example.com"}, want: true},
+		{name: "owner name", filter: namespaceFilter{owner: "cindy"}, want: true},
+		{name: "member not owner", filter: namespaceFilter{owner: "ramon"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.matches(namespace); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,12 +1,16 @@
 package okteto
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -14,12 +18,38 @@ const (
 	apiURL = "https://cloud.okteto.com/graphql"
 )
 
+// WorkloadKinds lists the workload kinds a pipeline can deploy, as named in the space query.
+var WorkloadKinds = []string{"deployments", "statefulsets", "jobs", "functions"}
+
+// InventoryKinds lists the kinds listed by the workload inventory, as named in the space query.
+var InventoryKinds = []string{"deployments", "statefulsets", "jobs", "cronjobs", "functions", "pods", "volumes"}
+
+// EndpointKinds lists the kinds that publish endpoints, as named in the space query.
+var EndpointKinds = []string{"deployments", "devs", "functions", "statefulsets", "externals"}
+
+// ErrUnauthorized is returned when the API rejects the token, because it is invalid, expired or revoked.
+var ErrUnauthorized = errors.New("the API token is invalid, expired or revoked")
+
+// queryCacheTTL is how long the result of a read query is reused before it is sent again.
+const queryCacheTTL = 5 * time.Second
+
 type Client struct {
 	Namespace  string
 	BaseURL    *url.URL
 	HTTPClient *http.Client
 
 	apiToken string
+
+	cacheMu sync.Mutex
+	cache   map[string]*cachedQuery
+}
+
+// cachedQuery is the result of a read query shared by every caller that sends the same query.
+type cachedQuery struct {
+	done    chan struct{}
+	result  *OktetoResponse
+	err     error
+	expires time.Time
 }
 
 // NewClient creates new Okteto client.
@@ -28,6 +58,7 @@ func NewClient(apiToken string, namespace string) *Client {
 		HTTPClient: &http.Client{Timeout: 30 * time.Second},
 		apiToken:   apiToken,
 		Namespace:  namespace,
+		cache:      map[string]*cachedQuery{},
 	}
 	c.BaseURL, _ = url.Parse(apiURL)
 	return c
@@ -49,80 +80,426 @@ type OktetoLocation struct {
 	Column int `json:"column"`
 }
 
+// NewSecret adds a secret to the user, replacing the value of the secret if it already exists.
 func (c *Client) NewSecret(name string, value string) error {
 	// Define the GraphQL mutation
-	mutation := `{"query":"mutation addSecret($name: String!, $value: String!) {\n  addSecret(name: $name, value: $value) {\n    name\n    value\n  }\n}","variables":{"name":"%s","value":"%s"},"operationName":"addSecret"}`
-	result, err := c.query(fmt.Sprintf(mutation, name, value))
+	mutation := `mutation addSecret($name: String!, $value: String!) {
+  addSecret(name: $name, value: $value) {
+    name
+  }
+}`
+	result, err := c.mutate(graphqlRequest("addSecret", mutation, map[string]interface{}{"name": name, "value": value}))
 	if err != nil {
 		return err
 	}
-	// Check if the secret was added successfully
 	if result.Data["addSecret"] == nil {
-		fmt.Println("Failed to add secret.")
-		fmt.Println("Response:", result)
-		return err
+		return responseError("failed to add secret", result)
 	}
-	fmt.Println("Secret added successfully!")
 	return nil
 }
 
-// func (c *Client) GetSecret(name string) error {
-// 	// Define the GraphQL mutation
-// 	query := `{"query":"query fetchUserAndTeam {\n  user {\n    ...UserFields\n  }\n  team {\n    id\n    name\n    avatar\n    members {\n      id\n      avatar\n      email\n      externalID\n      name\n      owner\n    }\n  }\n}\n\nfragment UserFields on me {\n  id\n  externalID\n  githubID\n  namespace\n  avatar\n  name\n  email\n  token\n  new\n  super\n  config\n  userDefinedNamespaces\n  plan\n  planSubscribed\n  trialExpiration\n  quotaPlan {\n    maxNamespaces\n    maxPods\n    scaleToZeroPeriod\n    enableSharing\n    enableCustomCatalog\n    enablePlans\n    limits {\n      cpu\n      memory\n      storage\n    }\n    limitRanges {\n      max {\n        cpu\n        memory\n      }\n    }\n  }\n  secrets {\n    name\n    value\n  }\n  personalAccessTokens {\n    id\n    name\n    expirationDate\n    status\n  }\n  secondaryEmails\n  quickstarts {\n    name\n    url\n    branch\n    default\n    variables {\n      name\n      value\n      options\n    }\n  }\n  integrations {\n    github {\n      enabled\n      url\n      connected\n      appInstallationUrl\n      authUrl\n    }\n  }\n  capabilities {\n    maxPersonalAccessTokens\n    teamsEnabled\n    automaticPreviewsEnabled\n    newOnboardingEnabled\n    helmCatalogEnabled\n    allowMembersShareNamespace\n    shareNamespaceOnlyWithUsersEnabled\n    namespacesPrefix\n    userNamespacesSuffix\n    installationBoardEnabled\n  }\n  team\n}","operationName":"fetchUserAndTeam"}`
-// 	result, err := c.query(query)
-// 	if err != nil {
-// 		return err
-// 	}
-// 	// Check if the secret exists
-// 	secrets := result["data"]["user"]["data"]["secrets"]["data"]
-// 	for secret, i := range(secrets) {
-// 		if secret["data"]["name"] == name {
-// 			fmt.Println("Secret exists!")
-// 			return  nil
-// 		}
-// 	}
-// 	fmt.Println("Secret doesn't exist!")
-// 	return  err
-// }
+// ListSecrets returns the secrets of the user.
+func (c *Client) ListSecrets() ([]map[string]interface{}, error) {
+	query := `query getSecrets {
+  user {
+    secrets {
+      name
+      value
+    }
+  }
+}`
+	result, err := c.cachedQuery(graphqlRequest("getSecrets", query, map[string]interface{}{}))
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Errors) > 0 {
+		return nil, fmt.Errorf("failed to get secrets: %s", result.Errors[0].Message)
+	}
+
+	user, ok := result.Data["user"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("could not get user data")
+	}
+
+	return listData(user, "secrets")
+}
 
+// DeleteSecret deletes a secret of the user.
 func (c *Client) DeleteSecret(name string) error {
-	// Define the GraphQL mutation
-	mutation := `{"query":"mutation deleteSecret($name: String!) {\n  deleteSecret(name: $name) {\n    name\n    value\n  }\n}","variables":{"name":"%s"},"operationName":"deleteSecret"}`
-	result, err := c.query(fmt.Sprintf(mutation, name))
+	mutation := `mutation deleteSecret($name: String!) {
+  deleteSecret(name: $name) {
+    name
+  }
+}`
+	result, err := c.mutate(graphqlRequest("deleteSecret", mutation, map[string]interface{}{"name": name}))
 	if err != nil {
 		return err
 	}
-	// Check if the secret was added successfully
 	if result.Data["deleteSecret"] == nil {
-		fmt.Println("Failed to delete secret.")
-		fmt.Println("Response:", result)
+		return responseError("failed to delete secret", result)
+	}
+	return nil
+}
+
+// NewNamespaceSecret adds a secret to the namespace, shared by every pipeline deployed in it.
+// The value of the secret is replaced if it already exists.
+func (c *Client) NewNamespaceSecret(namespace string, name string, value string) error {
+	mutation := `mutation addSpaceSecret($space: String!, $name: String!, $value: String!) {
+  addSpaceSecret(space: $space, name: $name, value: $value) {
+    name
+  }
+}`
+	result, err := c.mutate(graphqlRequest("addSpaceSecret", mutation, map[string]interface{}{"space": namespace, "name": name, "value": value}))
+	if err != nil {
 		return err
 	}
-	fmt.Println("Secret deleted successfully!")
+	if result.Data["addSpaceSecret"] == nil {
+		return responseError("failed to add namespace secret", result)
+	}
 	return nil
 }
 
-func (c *Client) NewPipeline(namespace string, name string, repo string, branch string) error {
-	// Define the GraphQL mutation
-	mutation := `{"query":"mutation deployGitRepository($name: String!, $space: String!, $source: String!, $branch: String, $repository: String!, $installationId: String, $variables: [InputVariable], $filename: String, $catalogItemId: String) {\n  deployGitRepository(\n    name: $name\n    space: $space\n    source: $source\n    branch: $branch\n    repository: $repository\n    installationId: $installationId\n    variables: $variables\n    filename: $filename\n    catalogItemId: $catalogItemId\n  ) {\n    gitDeploy {\n      id\n      status\n    }\n    action {\n      status\n    }\n  }\n}","variables":{"space":"%s","name":"%s","repository":"%s","branch":"%s","variables":[],"filename":"","source":"ui","catalogItemId":null},"operationName":"deployGitRepository"}`
+// ListNamespaceSecrets returns the secrets of the namespace.
+func (c *Client) ListNamespaceSecrets(namespace string) ([]map[string]interface{}, error) {
+	query := `query getSpaceSecrets($spaceId: String!) {
+  space(id: $spaceId) {
+    secrets {
+      name
+      value
+    }
+  }
+}`
+	result, err := c.cachedQuery(graphqlRequest("getSpaceSecrets", query, map[string]interface{}{"spaceId": namespace}))
+	if err != nil {
+		return nil, err
+	}
+	space, ok := result.Data["space"].(map[string]interface{})
+	if !ok {
+		return nil, responseError("could not get space data", result)
+	}
+	return listData(space, "secrets")
+}
+
+// DeleteNamespaceSecret deletes a secret of the namespace.
+func (c *Client) DeleteNamespaceSecret(namespace string, name string) error {
+	mutation := `mutation deleteSpaceSecret($space: String!, $name: String!) {
+  deleteSpaceSecret(space: $space, name: $name) {
+    name
+  }
+}`
+	result, err := c.mutate(graphqlRequest("deleteSpaceSecret", mutation, map[string]interface{}{"space": namespace, "name": name}))
+	if err != nil {
+		return err
+	}
+	if result.Data["deleteSpaceSecret"] == nil {
+		return responseError("failed to delete namespace secret", result)
+	}
+	return nil
+}
+
+// NewGlobalSecret adds an admin secret shared by every pipeline of the Okteto instance.
+// The value of the secret is replaced if it already exists.
+func (c *Client) NewGlobalSecret(name string, value string) error {
+	mutation := `mutation addGlobalSecret($name: String!, $value: String!) {
+  addGlobalSecret(name: $name, value: $value) {
+    name
+  }
+}`
+	result, err := c.mutate(graphqlRequest("addGlobalSecret", mutation, map[string]interface{}{"name": name, "value": value}))
+	if err != nil {
+		return err
+	}
+	if result.Data["addGlobalSecret"] == nil {
+		return responseError("failed to add global secret", result)
+	}
+	return nil
+}
+
+// ListGlobalSecrets returns the admin secrets of the Okteto instance.
+func (c *Client) ListGlobalSecrets() ([]map[string]interface{}, error) {
+	query := `query getGlobalSecrets {
+  globalSecrets {
+    name
+    value
+  }
+}`
+	result, err := c.cachedQuery(graphqlRequest("getGlobalSecrets", query, map[string]interface{}{}))
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Errors) > 0 {
+		return nil, responseError("failed to get global secrets", result)
+	}
+	return listData(result.Data, "globalSecrets")
+}
+
+// DeleteGlobalSecret deletes an admin secret of the Okteto instance.
+func (c *Client) DeleteGlobalSecret(name string) error {
+	mutation := `mutation deleteGlobalSecret($name: String!) {
+  deleteGlobalSecret(name: $name) {
+    name
+  }
+}`
+	result, err := c.mutate(graphqlRequest("deleteGlobalSecret", mutation, map[string]interface{}{"name": name}))
+	if err != nil {
+		return err
+	}
+	if result.Data["deleteGlobalSecret"] == nil {
+		return responseError("failed to delete global secret", result)
+	}
+	return nil
+}
+
+// ListPersonalAccessTokens returns the personal access tokens of the user, along with the maximum number
+// of tokens the user can have.
+func (c *Client) ListPersonalAccessTokens() ([]map[string]interface{}, int, error) {
+	query := `query getPersonalAccessTokens {
+  user {
+    personalAccessTokens {
+      id
+      name
+      expirationDate
+      status
+    }
+    capabilities {
+      maxPersonalAccessTokens
+    }
+  }
+}`
+	result, err := c.cachedQuery(graphqlRequest("getPersonalAccessTokens", query, map[string]interface{}{}))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	user, ok := result.Data["user"].(map[string]interface{})
+	if !ok {
+		return nil, 0, responseError("could not get user data", result)
+	}
+
+	tokens, err := listData(user, "personalAccessTokens")
+	if err != nil {
+		return nil, 0, err
+	}
+	capabilities, _ := user["capabilities"].(map[string]interface{})
+	maxTokens, _ := capabilities["maxPersonalAccessTokens"].(float64)
+	return tokens, int(maxTokens), nil
+}
+
+// NewPersonalAccessToken creates a personal access token for the user. The token value is only returned
+// on creation. Tokens without an expiration date never expire.
+func (c *Client) NewPersonalAccessToken(name string, expirationDate string) (map[string]interface{}, error) {
+	mutation := `mutation createPersonalAccessToken($name: String!, $expirationDate: String) {
+  createPersonalAccessToken(name: $name, expirationDate: $expirationDate) {
+    id
+    name
+    token
+    expirationDate
+    status
+  }
+}`
+	variables := map[string]interface{}{"name": name, "expirationDate": nil}
+	if expirationDate != "" {
+		variables["expirationDate"] = expirationDate
+	}
+	result, err := c.mutate(graphqlRequest("createPersonalAccessToken", mutation, variables))
+	if err != nil {
+		return nil, err
+	}
+
+	token, ok := result.Data["createPersonalAccessToken"].(map[string]interface{})
+	if !ok {
+		return nil, responseError("failed to create personal access token", result)
+	}
+	return token, nil
+}
 
-	result, err := c.query(fmt.Sprintf(mutation, namespace, name, repo, branch))
+// RevokePersonalAccessToken revokes a personal access token of the user.
+func (c *Client) RevokePersonalAccessToken(id string) error {
+	mutation := `mutation revokePersonalAccessToken($id: String!) {
+  revokePersonalAccessToken(id: $id) {
+    id
+  }
+}`
+	result, err := c.mutate(graphqlRequest("revokePersonalAccessToken", mutation, map[string]interface{}{"id": id}))
 	if err != nil {
 		return err
 	}
+	if result.Data["revokePersonalAccessToken"] == nil {
+		return responseError("failed to revoke personal access token", result)
+	}
+	return nil
+}
+
+// GetUser returns the user the API token belongs to, with its quota plan and capabilities.
+func (c *Client) GetUser() (map[string]interface{}, error) {
+	query := `query fetchUser {
+  user {
+    id
+    name
+    email
+    namespace
+    plan
+    team
+    quotaPlan {
+      maxNamespaces
+      maxPods
+      scaleToZeroPeriod
+      enableSharing
+      limits {
+        cpu
+        memory
+        storage
+      }
+    }
+    capabilities {
+      maxPersonalAccessTokens
+      teamsEnabled
+      automaticPreviewsEnabled
+      helmCatalogEnabled
+      allowMembersShareNamespace
+      namespacesPrefix
+      userNamespacesSuffix
+    }
+  }
+}`
+	result, err := c.cachedQuery(graphqlRequest("fetchUser", query, map[string]interface{}{}))
+	if err != nil {
+		return nil, err
+	}
+
+	user, ok := result.Data["user"].(map[string]interface{})
+	if !ok {
+		return nil, responseError("could not get user data", result)
+	}
+	return copyMap(user), nil
+}
+
+// quotaFields lists the fields selected for each quota of a namespace.
+const quotaFields = "limits limitsTotal requests requestsTotal total used"
+
+// GetNamespace returns the status, members and quotas of the namespace.
+func (c *Client) GetNamespace(namespace string) (map[string]interface{}, error) {
+	query := fmt.Sprintf(`query getSpaceQuotas($spaceId: String!) {
+  space(id: $spaceId) {
+    id
+    status
+    scope
+    persistent
+    members {
+      id
+      name
+      email
+      owner
+    }
+    quotas {
+      cpu { %[1]s }
+      memory { %[1]s }
+      pods { %[1]s }
+      storage { %[1]s }
+    }
+  }
+}`, quotaFields)
+	result, err := c.cachedQuery(graphqlRequest("getSpaceQuotas", query, map[string]interface{}{"spaceId": namespace}))
+	if err != nil {
+		return nil, err
+	}
+
+	space, ok := result.Data["space"].(map[string]interface{})
+	if !ok {
+		return nil, responseError(fmt.Sprintf("could not get namespace %s", namespace), result)
+	}
+	return copyMap(space), nil
+}
+
+// ListNamespaces returns the personal and shared namespaces the user can access.
+func (c *Client) ListNamespaces() ([]map[string]interface{}, error) {
+	query := `query getSpaces {
+  spaces {
+    id
+    status
+    scope
+    persistent
+    members {
+      id
+      name
+      email
+      owner
+    }
+  }
+}`
+	result, err := c.cachedQuery(graphqlRequest("getSpaces", query, map[string]interface{}{}))
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Errors) > 0 {
+		return nil, responseError("could not get namespaces", result)
+	}
+
+	return listData(result.Data, "spaces")
+}
+
+// NewPipeline schedules the deployment of a pipeline and returns the action that deploys it.
+func (c *Client) NewPipeline(namespace string, name string, repo string, branch string) (map[string]interface{}, error) {
+	// Define the GraphQL mutation
+	mutation := `{"query":"mutation deployGitRepository($name: String!, $space: String!, $source: String!, $branch: String, $repository: String!, $installationId: String, $variables: [InputVariable], $filename: String, $catalogItemId: String) {\n  deployGitRepository(\n    name: $name\n    space: $space\n    source: $source\n    branch: $branch\n    repository: $repository\n    installationId: $installationId\n    variables: $variables\n    filename: $filename\n    catalogItemId: $catalogItemId\n  ) {\n    gitDeploy {\n      id\n      status\n    }\n    action {\n      id\n      name\n      status\n    }\n  }\n}","variables":{"space":"%s","name":"%s","repository":"%s","branch":"%s","variables":[],"filename":"","source":"ui","catalogItemId":null},"operationName":"deployGitRepository"}`
+
+	result, err := c.mutate(fmt.Sprintf(mutation, namespace, name, repo, branch))
+	if err != nil {
+		return nil, err
+	}
 	// Check if the pipeline was scheduled successfully
-	if result.Data["deployGitRepository"] == nil {
+	deploy, ok := result.Data["deployGitRepository"].(map[string]interface{})
+	if !ok {
 		fmt.Println("Failed to add pipeline.")
 		fmt.Println("Response:", result)
-		return err
+		return nil, responseError("failed to deploy pipeline", result)
 	}
 	fmt.Println("Pipline scheduled successfully!")
-	return nil
+	action, _ := deploy["action"].(map[string]interface{})
+	return action, nil
 }
 
+// GetPipeline returns the pipeline with the given name, along with the workloads of every kind it deployed
+// and their pods. It returns nil if the pipeline doesn't exist.
 func (c *Client) GetPipeline(namespace string, name string) (map[string]interface{}, error) {
-	query := `{"query":"query getSpace($spaceId: String!) {\n  space(id: $spaceId) {\n    id\n    status\n    quotas {\n      ...QuotasFields\n    }\n    members {\n      ...MemberFields\n    }\n    apps {\n      ...AppFields\n    }\n    gitDeploys {\n      ...GitDeployFields\n    }\n    devs {\n      ...DevFields\n    }\n    deployments {\n      ...DeploymentFields\n    }\n    pods {\n      ...PodFields\n    }\n    functions {\n      ...FunctionFields\n    }\n    statefulsets {\n      ...StatefulsetFields\n    }\n    jobs {\n      ...JobFields\n    }\n    cronjobs {\n      ...CronjobFields\n    }\n    volumes {\n      ...VolumeFields\n    }\n    externals {\n      ...ExternalResourceFields\n    }\n    scope\n    persistent\n  }\n}\n\nfragment QuotasFields on Quotas {\n  cpu {\n    ...QuotaFields\n  }\n  memory {\n    ...QuotaFields\n  }\n  pods {\n    ...QuotaFields\n  }\n  storage {\n    ...QuotaFields\n  }\n}\n\nfragment QuotaFields on Resource {\n  limits\n  limitsTotal\n  requests\n  requestsTotal\n  total\n  used\n}\n\nfragment MemberFields on Member {\n  id\n  avatar\n  email\n  externalID\n  name\n  owner\n}\n\nfragment AppFields on App {\n  id\n  name\n  version\n  chart\n  icon\n  description\n  repo\n  config\n  status\n  actionName\n  createdAt\n  updatedAt\n}\n\nfragment GitDeployFields on GitDeploy {\n  id\n  name\n  icon\n  yaml\n  repository\n  repoFullName\n  filename\n  branch\n  status\n  actionName\n  variables {\n    name\n    value\n  }\n  github {\n    installationId\n  }\n  gitCatalogItem {\n    id\n    name\n  }\n  createdAt\n  updatedAt\n}\n\nfragment DevFields on Dev {\n  id\n  name\n  deployedBy\n  yaml\n  error\n  status\n  replicas\n  numPods\n  createdAt\n  updatedAt\n  divert\n  cpu {\n    ...QuotaFields\n  }\n  memory {\n    ...QuotaFields\n  }\n  endpoints {\n    ...EndpointFields\n  }\n}\n\nfragment EndpointFields on Endpoint {\n  url\n  private\n  divert\n}\n\nfragment DeploymentFields on Deployment {\n  id\n  name\n  deployedBy\n  yaml\n  error\n  status\n  devmode\n  repository\n  path\n  replicas\n  numPods\n  createdAt\n  updatedAt\n  cpu {\n    ...QuotaFields\n  }\n  memory {\n    ...QuotaFields\n  }\n  endpoints {\n    ...EndpointFields\n  }\n}\n\nfragment PodFields on Pod {\n  id\n  name\n  yaml\n  createdAt\n  updatedAt\n  error\n  status\n  deployedBy\n  cpu {\n    ...QuotaFields\n  }\n  memory {\n    ...QuotaFields\n  }\n}\n\nfragment FunctionFields on Function {\n  id\n  name\n  deployedBy\n  yaml\n  error\n  status\n  devmode\n  replicas\n  numPods\n  createdAt\n  updatedAt\n  cpu {\n    ...QuotaFields\n  }\n  memory {\n    ...QuotaFields\n  }\n  endpoints {\n    ...EndpointFields\n  }\n}\n\nfragment StatefulsetFields on StatefulSet {\n  id\n  name\n  deployedBy\n  yaml\n  error\n  status\n  replicas\n  numPods\n  createdAt\n  updatedAt\n  devmode\n  cpu {\n    ...QuotaFields\n  }\n  memory {\n    ...QuotaFields\n  }\n  endpoints {\n    ...EndpointFields\n  }\n}\n\nfragment JobFields on Job {\n  id\n  name\n  deployedBy\n  yaml\n  error\n  status\n  replicas\n  numPods\n  createdAt\n  updatedAt\n  cpu {\n    ...QuotaFields\n  }\n  memory {\n    ...QuotaFields\n  }\n}\n\nfragment CronjobFields on CronJob {\n  id\n  name\n  deployedBy\n  yaml\n  error\n  status\n  createdAt\n  updatedAt\n}\n\nfragment VolumeFields on Volume {\n  id\n  name\n  createdByDevmode\n  deployedBy\n  yaml\n  status\n  createdAt\n  updatedAt\n  storage {\n    ...QuotaFields\n  }\n}\n\nfragment ExternalResourceFields on ExternalResource {\n  id\n  name\n  icon\n  createdAt\n  updatedAt\n  deployedBy\n  endpoints {\n    url\n  }\n  notes {\n    path\n    markdown\n  }\n}","variables":{"spaceId":"%s"},"operationName":"getSpace"}`
-	result, err := c.query(fmt.Sprintf(query, namespace))
+	pipeline, err := c.GetGitDeploy(namespace, name)
+	if err != nil || pipeline == nil {
+		return nil, err
+	}
+
+	// Attach the workloads of every kind deployed by this pipeline, and their pods
+	workloads, err := c.ListWorkloads(namespace, append(WorkloadKinds, "pods"), PipelineDeployedBy(name))
+	if err != nil {
+		return nil, err
+	}
+	for kind, w := range workloads {
+		pipeline[kind] = w
+	}
+	return pipeline, nil
+}
+
+// ListGitDeploys returns the pipelines deployed in the namespace.
+func (c *Client) ListGitDeploys(namespace string) ([]map[string]interface{}, error) {
+	query := `query getGitDeploys($spaceId: String!) {
+  space(id: $spaceId) {
+    gitDeploys {
+      id
+      name
+      repository
+      filename
+      branch
+      status
+      actionName
+      variables {
+        name
+        value
+      }
+      createdAt
+      updatedAt
+    }
+  }
+}`
+	result, err := c.cachedQuery(graphqlRequest("getGitDeploys", query, map[string]interface{}{"spaceId": namespace}))
 	if err != nil {
 		return nil, err
 	}
@@ -132,76 +509,333 @@ func (c *Client) GetPipeline(namespace string, name string) (map[string]interfac
 		return nil, fmt.Errorf("could not get space data")
 	}
 
-	gitDeploys, ok := space["gitDeploys"].([]interface{})
-	if !ok {
-		return nil, fmt.Errorf("could not get gitDeploys data")
+	return listData(space, "gitDeploys")
+}
+
+// GetGitDeploy returns the pipeline with the given name, or nil if it doesn't exist.
+func (c *Client) GetGitDeploy(namespace string, name string) (map[string]interface{}, error) {
+	gitDeploys, err := c.ListGitDeploys(namespace)
+	if err != nil {
+		return nil, err
 	}
 
-	deployments, ok := space["deployments"].([]interface{})
-	if !ok {
-		return nil, fmt.Errorf("could not get deployments data")
+	for _, pipelineData := range gitDeploys {
+		if pipelineName, _ := pipelineData["name"].(string); pipelineName == name {
+			// Copy the pipeline data so the cached query result is left untouched
+			return copyMap(pipelineData), nil
+		}
 	}
+	fmt.Println("Pipeline doesn't exist!")
+	return nil, nil
+}
+
+// workloadFields lists the fields selected for each workload kind.
+var workloadFields = map[string]string{
+	"deployments":  "id name deployedBy error status replicas numPods createdAt updatedAt cpu { used } memory { used } endpoints { url private divert }",
+	"statefulsets": "id name deployedBy error status replicas numPods createdAt updatedAt cpu { used } memory { used } endpoints { url private divert }",
+	"functions":    "id name deployedBy error status replicas numPods createdAt updatedAt cpu { used } memory { used } endpoints { url private divert }",
+	"jobs":         "id name deployedBy error status replicas numPods createdAt updatedAt cpu { used } memory { used }",
+	"cronjobs":     "id name deployedBy error status createdAt updatedAt",
+	"pods":         "id name deployedBy error status createdAt updatedAt cpu { used } memory { used }",
+	"volumes":      "id name deployedBy status createdAt updatedAt storage { used }",
+	"devs":         "id name deployedBy error status replicas numPods endpoints { url private divert }",
+	"externals":    "id name icon deployedBy endpoints { url } notes { path markdown }",
+}
 
-	for _, pipeline := range gitDeploys {
-		pipelineData, ok := pipeline.(map[string]interface{})
+// ListWorkloads returns the workloads of the given kinds in the namespace, keyed by kind.
+// Only workloads deployed by deployedBy are returned, unless it is empty.
+func (c *Client) ListWorkloads(namespace string, kinds []string, deployedBy string) (map[string][]map[string]interface{}, error) {
+	var query strings.Builder
+	query.WriteString("query listWorkloads($spaceId: String!) {\n  space(id: $spaceId) {\n")
+	for _, kind := range kinds {
+		fields, ok := workloadFields[kind]
 		if !ok {
-			return nil, fmt.Errorf("could not get pipeline data")
+			return nil, fmt.Errorf("unknown workload kind %s", kind)
 		}
+		fmt.Fprintf(&query, "    %s {\n      %s\n    }\n", kind, fields)
+	}
+	query.WriteString("  }\n}")
 
-		pipelineName, ok := pipelineData["name"].(string)
-		if !ok || pipelineName != name {
-			continue
+	result, err := c.cachedQuery(graphqlRequest("listWorkloads", query.String(), map[string]interface{}{"spaceId": namespace}))
+	if err != nil {
+		return nil, err
+	}
+
+	space, ok := result.Data["space"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("could not get space data")
+	}
+
+	workloads := map[string][]map[string]interface{}{}
+	for _, kind := range kinds {
+		workloads[kind], err = filterWorkloads(space, kind, deployedBy)
+		if err != nil {
+			return nil, err
 		}
+	}
+	return workloads, nil
+}
 
-		// Initialize an empty slice to store deployments for this pipeline
-		pipelineDeployments := []map[string]interface{}{}
+// maxResourceNameLength is the maximum length of a Kubernetes resource name or label value.
+const maxResourceNameLength = 63
 
-		for _, deployment := range deployments {
-			deploymentData, ok := deployment.(map[string]interface{})
-			if !ok {
-				return nil, fmt.Errorf("could not get deployment data")
-			}
+// invalidResourceNameChars matches the characters Okteto replaces when deriving a resource name.
+var invalidResourceNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
 
-			deployedBy, ok := deploymentData["deployedBy"].(string)
-			if !ok {
-				return nil, fmt.Errorf("could not get 'deployedBy' field from deployment data")
-			}
+// PipelineDeployedBy returns the value of the deployedBy field of the workloads deployed by the pipeline.
+// It follows the rules Okteto uses to derive a resource name: the name is lowercased, every run of
+// characters other than letters, digits and hyphens is replaced with a hyphen, leading and trailing
+// hyphens are trimmed and the result is truncated to 63 characters.
+func PipelineDeployedBy(pipelineName string) string {
+	name := strings.ToLower(pipelineName)
+	name = invalidResourceNameChars.ReplaceAllString(name, "-")
+	name = strings.Trim(name, "-")
+	if len(name) > maxResourceNameLength {
+		name = strings.TrimRight(name[:maxResourceNameLength], "-")
+	}
+	return name
+}
 
-			if deployedBy == strings.Replace(pipelineName, "_", "-", -1) {
-				// Append the deployment data to the pipelineDeployments slice
-				pipelineDeployments = append(pipelineDeployments, deploymentData)
-			}
+// PipelineNameCollisions returns the names of the other pipelines in the namespace whose workloads
+// can't be told apart from the workloads of the named pipeline.
+func (c *Client) PipelineNameCollisions(namespace string, name string) ([]string, error) {
+	gitDeploys, err := c.ListGitDeploys(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return nameCollisions(gitDeploys, name), nil
+}
+
+func nameCollisions(gitDeploys []map[string]interface{}, name string) []string {
+	collisions := []string{}
+	deployedBy := PipelineDeployedBy(name)
+	for _, pipelineData := range gitDeploys {
+		pipelineName, _ := pipelineData["name"].(string)
+		if pipelineName != name && PipelineDeployedBy(pipelineName) == deployedBy {
+			collisions = append(collisions, pipelineName)
 		}
+	}
+	return collisions
+}
 
-		// Assign the pipelineDeployments slice to pipelineData["deployments"]
-		pipelineData["deployments"] = pipelineDeployments
-		return pipelineData, nil
+// listData returns the list held by the given field of the data.
+func listData(data map[string]interface{}, field string) ([]map[string]interface{}, error) {
+	list := []map[string]interface{}{}
+	if data[field] == nil {
+		return list, nil
 	}
-	fmt.Println("Pipeline doesn't exist!")
-	return nil, nil
+	items, ok := data[field].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("could not get %s data", field)
+	}
+	for _, item := range items {
+		itemData, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("could not get %s data", field)
+		}
+		list = append(list, itemData)
+	}
+	return list, nil
 }
 
-func (c *Client) DestroyPipeline(name string, namespace string, force bool) error {
+// filterWorkloads returns the workloads of the given kind in the space data that were deployed by deployedBy.
+// Every workload of the kind is returned when deployedBy is empty.
+func filterWorkloads(space map[string]interface{}, kind string, deployedBy string) ([]map[string]interface{}, error) {
+	workloads, err := listData(space, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize an empty slice to store workloads for this pipeline
+	filtered := []map[string]interface{}{}
+
+	for _, workloadData := range workloads {
+		// Workloads that were not deployed by a pipeline have no deployedBy field
+		workloadDeployedBy, _ := workloadData["deployedBy"].(string)
+
+		if deployedBy == "" || workloadDeployedBy == deployedBy {
+			filtered = append(filtered, workloadData)
+		}
+	}
+	return filtered, nil
+}
+
+// DestroyPipeline schedules the destruction of a pipeline and returns the action that destroys it.
+func (c *Client) DestroyPipeline(name string, namespace string, force bool) (map[string]interface{}, error) {
 	// Define the GraphQL mutation
-	mutation := `	{"query":"mutation destroyGitRepository($name: String!, $spaceId: String!, $destroyVolumes: Boolean, $forceDestroy: Boolean) {\n  destroyGitRepository(\n    name: $name\n    space: $spaceId\n    destroyVolumes: $destroyVolumes\n    forceDestroy: $forceDestroy\n  ) {\n    gitDeploy {\n      name\n    }\n    action {\n      status\n    }\n  }\n}","variables":{"name":"%s","spaceId":"%s","destroyVolumes":true,"forceDestroy":%s},"operationName":"destroyGitRepository"}`
+	mutation := `	{"query":"mutation destroyGitRepository($name: String!, $spaceId: String!, $destroyVolumes: Boolean, $forceDestroy: Boolean) {\n  destroyGitRepository(\n    name: $name\n    space: $spaceId\n    destroyVolumes: $destroyVolumes\n    forceDestroy: $forceDestroy\n  ) {\n    gitDeploy {\n      name\n    }\n    action {\n      id\n      name\n      status\n    }\n  }\n}","variables":{"name":"%s","spaceId":"%s","destroyVolumes":true,"forceDestroy":%s},"operationName":"destroyGitRepository"}`
 	sforce := "false"
 	if force {
 		sforce = "true"
 	}
-	result, err := c.query(fmt.Sprintf(mutation, name, namespace, sforce))
+	result, err := c.mutate(fmt.Sprintf(mutation, name, namespace, sforce))
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if result.Data["destroyGitRepository"] == nil && result.Errors[0].Message != "not-found" {
-		return fmt.Errorf("failed to destroy pipeline: %s", result.Errors[0].Message)
+	if result.Data["destroyGitRepository"] == nil {
+		// A pipeline that no longer exists is already destroyed
+		if len(result.Errors) > 0 && result.Errors[0].Message == "not-found" {
+			return nil, nil
+		}
+		return nil, responseError("failed to destroy pipeline", result)
 	}
 	fmt.Println("Pipeline destroy initiated successfully!")
-	return nil
+	destroy, _ := result.Data["destroyGitRepository"].(map[string]interface{})
+	action, _ := destroy["action"].(map[string]interface{})
+	return action, nil
+}
+
+// GetAction returns the status of a pipeline action.
+func (c *Client) GetAction(namespace string, name string) (map[string]interface{}, error) {
+	query := `query getAction($name: String!, $space: String!) {
+  action(name: $name, space: $space) {
+    id
+    name
+    status
+  }
+}`
+	// The action status is polled, so the query is never cached
+	result, err := c.query(graphqlRequest("getAction", query, map[string]interface{}{"name": name, "space": namespace}))
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Errors) > 0 {
+		return nil, fmt.Errorf("failed to get action: %s", result.Errors[0].Message)
+	}
+
+	action, ok := result.Data["action"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("could not get action data")
+	}
+	return action, nil
+}
+
+// GetPipelineLogs returns the last lines of the log output of a pipeline action.
+func (c *Client) GetPipelineLogs(namespace string, name string, actionName string, lines int) ([]string, error) {
+	logsURL := url.URL{
+		Scheme:   c.BaseURL.Scheme,
+		Host:     c.BaseURL.Host,
+		Path:     fmt.Sprintf("/sse/logs/%s/gitdeploy/%s", namespace, name),
+		RawQuery: url.Values{"action": []string{actionName}}.Encode(),
+	}
+	req, err := http.NewRequest("GET", logsURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.apiToken)
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get pipeline logs: %s", resp.Status)
+	}
+
+	// The logs are streamed as server-sent events, one log line per event
+	logs := []string{}
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if !strings.HasPrefix(scanner.Text(), "data:") {
+			continue
+		}
+		data := strings.TrimPrefix(scanner.Text(), "data:")
+		var event struct {
+			Line string `json:"line"`
+		}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(data)), &event); err != nil {
+			continue
+		}
+		if event.Line == "EOF" {
+			break
+		}
+		var line struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal([]byte(event.Line), &line); err == nil && line.Message != "" {
+			event.Line = line.Message
+		}
+		logs = append(logs, event.Line)
+		if len(logs) > lines {
+			logs = logs[1:]
+		}
+	}
+	if err := scanner.Err(); err != nil && len(logs) == 0 {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// cachedQuery sends a read query, sharing the result with concurrent callers sending the same query
+// and reusing it for queryCacheTTL. The result must not be modified.
+func (c *Client) cachedQuery(query string) (*OktetoResponse, error) {
+	c.cacheMu.Lock()
+	if entry, ok := c.cache[query]; ok {
+		select {
+		case <-entry.done:
+			if entry.err == nil && time.Now().Before(entry.expires) {
+				c.cacheMu.Unlock()
+				return entry.result, nil
+			}
+		default:
+			// The same query is already in flight, wait for its result
+			c.cacheMu.Unlock()
+			<-entry.done
+			return entry.result, entry.err
+		}
+	}
+	entry := &cachedQuery{done: make(chan struct{})}
+	c.cache[query] = entry
+	c.cacheMu.Unlock()
+
+	entry.result, entry.err = c.query(query)
+	entry.expires = time.Now().Add(queryCacheTTL)
+	close(entry.done)
+	return entry.result, entry.err
+}
+
+// mutate sends a mutation and invalidates every cached query result.
+func (c *Client) mutate(mutation string) (*OktetoResponse, error) {
+	result, err := c.query(mutation)
+	c.cacheMu.Lock()
+	c.cache = map[string]*cachedQuery{}
+	c.cacheMu.Unlock()
+	return result, err
+}
+
+// responseError returns an error with the message, followed by the first error of the response if any.
+func responseError(message string, result *OktetoResponse) error {
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("%s: %s", message, result.Errors[0].Message)
+	}
+	return fmt.Errorf("%s", message)
+}
+
+// graphqlRequest encodes a GraphQL operation as a request body.
+func graphqlRequest(operationName string, query string, variables map[string]interface{}) string {
+	body, _ := json.Marshal(map[string]interface{}{
+		"query":         query,
+		"variables":     variables,
+		"operationName": operationName,
+	})
+	return string(body)
+}
+
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		copied[k] = v
+	}
+	return copied
 }
 
 func (c *Client) query(query string) (*OktetoResponse, error) {
 	// Prepare the API request
-	req, err := http.NewRequest("POST", apiURL, bytes.NewBufferString(query))
+	req, err := http.NewRequest("POST", c.BaseURL.String(), bytes.NewBufferString(query))
 	if err != nil {
 		fmt.Println("Error creating request:", err)
 		return nil, err
@@ -210,8 +844,7 @@ func (c *Client) query(query string) (*OktetoResponse, error) {
 	req.Header.Set("Content-Type", "application/json")
 
 	// Send the API request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		fmt.Println("Error sending request:", err)
 		return nil, err
@@ -219,6 +852,9 @@ func (c *Client) query(query string) (*OktetoResponse, error) {
 	defer resp.Body.Close()
 
 	// Check the API response
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, fmt.Errorf("failed to execute query: %w", ErrUnauthorized)
+	}
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("failed to execute query: %s", resp.Status)
 	}
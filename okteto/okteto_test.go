@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPipelineDeployedBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		pipeline string
+		want     string
+	}{
+		{name: "valid", pipeline: "movies", want: "movies"},
+		{name: "underscores", pipeline: "okteto_aws_lambda", want: "okteto-aws-lambda"},
+		{name: "dots", pipeline: "api.v2", want: "api-v2"},
+		{name: "uppercase", pipeline: "MyApp", want: "myapp"},
+		{name: "runs of invalid characters", pipeline: "my__app..v2", want: "my-app-v2"},
+		{name: "leading and trailing invalid characters", pipeline: "_my-app.", want: "my-app"},
+		{name: "long", pipeline: strings.Repeat("a", 70), want: strings.Repeat("a", 63)},
+		{name: "long ending with hyphen", pipeline: strings.Repeat("a", 62) + "_b", want: strings.Repeat("a", 62)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PipelineDeployedBy(tt.pipeline); got != tt.want {
+				t.Errorf("PipelineDeployedBy(%q) = %q, want %q", tt.pipeline, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNameCollisions(t *testing.T) {
+	gitDeploys := []map[string]interface{}{
+		{"name": "my_app"},
+		{"name": "my-app"},
+		{"name": "My.App"},
+		{"name": "other"},
+	}
+	want := []string{"my-app", "My.App"}
+	if got := nameCollisions(gitDeploys, "my_app"); !reflect.DeepEqual(got, want) {
+		t.Errorf("nameCollisions() = %v, want %v", got, want)
+	}
+	if got := nameCollisions(gitDeploys, "other"); len(got) != 0 {
+		t.Errorf("nameCollisions() = %v, want none", got)
+	}
+}
+
+// newTestClient returns a client sending its queries to the handler, and the number of requests the handler got.
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *int32) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		r.Header.Set("X-Request", fmt.Sprint(n))
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	c := NewClient("token", "namespace")
+	c.BaseURL, _ = url.Parse(server.URL)
+	return c, &requests
+}
+
+// respondRequestNumber responds with the number of the request, so that responses can be told apart.
+func respondRequestNumber(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprintf(w, `{"data": {"request": %s}}`, r.Header.Get("X-Request"))
+}
+
+func TestCachedQueryCoalescesConcurrentCalls(t *testing.T) {
+	received := make(chan struct{})
+	release := make(chan struct{})
+	c, requests := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		close(received)
+		<-release
+		respondRequestNumber(w, r)
+	})
+
+	const callers = 5
+	results := make([]*OktetoResponse, callers)
+	errs := make([]error, callers)
+	var wg sync.WaitGroup
+	call := func(i int) {
+		defer wg.Done()
+		results[i], errs[i] = c.cachedQuery("query")
+	}
+
+	wg.Add(1)
+	go call(0)
+	// The first call is in flight, the others wait for its result
+	<-received
+	for i := 1; i < callers; i++ {
+		wg.Add(1)
+		go call(i)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+	for i := range results {
+		if errs[i] != nil {
+			t.Fatalf("cachedQuery() error = %s", errs[i])
+		}
+		if results[i] != results[0] {
+			t.Errorf("cachedQuery() result %d is not shared with the first call", i)
+		}
+	}
+}
+
+func TestCachedQueryReusesResultUntilExpiry(t *testing.T) {
+	c, requests := newTestClient(t, respondRequestNumber)
+
+	first, err := c.cachedQuery("query")
+	if err != nil {
+		t.Fatalf("cachedQuery() error = %s", err)
+	}
+	second, err := c.cachedQuery("query")
+	if err != nil {
+		t.Fatalf("cachedQuery() error = %s", err)
+	}
+	if second != first || atomic.LoadInt32(requests) != 1 {
+		t.Errorf("cachedQuery() sent %d requests before expiry, want 1", atomic.LoadInt32(requests))
+	}
+
+	if _, err := c.cachedQuery("other query"); err != nil {
+		t.Fatalf("cachedQuery() error = %s", err)
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("cachedQuery() sent %d requests for two different queries, want 2", got)
+	}
+
+	c.cache["query"].expires = time.Now().Add(-time.Second)
+	third, err := c.cachedQuery("query")
+	if err != nil {
+		t.Fatalf("cachedQuery() error = %s", err)
+	}
+	if third == first || atomic.LoadInt32(requests) != 3 {
+		t.Errorf("cachedQuery() reused an expired result")
+	}
+}
+
+func TestCachedQueryDoesNotCacheErrors(t *testing.T) {
+	c, requests := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Request") == "1" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		respondRequestNumber(w, r)
+	})
+
+	if _, err := c.cachedQuery("query"); err == nil {
+		t.Fatal("cachedQuery() expected an error")
+	}
+	result, err := c.cachedQuery("query")
+	if err != nil {
+		t.Fatalf("cachedQuery() error = %s", err)
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+	if result.Data["request"] != float64(2) {
+		t.Errorf("cachedQuery() = %v, want the response to the second request", result.Data)
+	}
+}
+
+func TestMutateInvalidatesCachedQueries(t *testing.T) {
+	c, requests := newTestClient(t, respondRequestNumber)
+
+	first, err := c.cachedQuery("query")
+	if err != nil {
+		t.Fatalf("cachedQuery() error = %s", err)
+	}
+	if _, err := c.mutate("mutation"); err != nil {
+		t.Fatalf("mutate() error = %s", err)
+	}
+	second, err := c.cachedQuery("query")
+	if err != nil {
+		t.Fatalf("cachedQuery() error = %s", err)
+	}
+	if got := atomic.LoadInt32(requests); got != 3 {
+		t.Errorf("requests = %d, want 3", got)
+	}
+	if second == first {
+		t.Error("cachedQuery() reused a result cached before the mutation")
+	}
+}
+
+func TestDeleteSecret(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"deleteSecret": null}, "errors": [{"message": "not-found"}]}`)
+	})
+
+	err := c.DeleteSecret("DB")
+	if err == nil || err.Error() != "failed to delete secret: not-found" {
+		t.Errorf("DeleteSecret() error = %v, want failed to delete secret: not-found", err)
+	}
+}
+
+func TestGetPipelineLogs(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/sse/logs/namespace/gitdeploy/movies" || r.URL.Query().Get("action") != "deploy-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, `event: message
+data: {"line": "{\"message\": \"one\"}"}
+
+data: {"line": "two"}
+
+data: not json
+
+data: {"line": "three"}
+
+data: {"line": "EOF"}
+
+data: {"line": "after EOF"}
+
+`)
+	})
+
+	tests := []struct {
+		name  string
+		lines int
+		want  []string
+	}{
+		{name: "whole stream", lines: 10, want: []string{"one", "two", "three"}},
+		{name: "last lines", lines: 2, want: []string{"two", "three"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.GetPipelineLogs("namespace", "movies", "deploy-1", tt.lines)
+			if err != nil {
+				t.Fatalf("GetPipelineLogs() error = %s", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetPipelineLogs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := c.GetPipelineLogs("namespace", "unknown", "deploy-1", 10); err == nil {
+		t.Error("GetPipelineLogs() expected an error for a pipeline without logs")
+	}
+}
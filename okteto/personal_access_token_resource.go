@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PersonalAccessTokenResource{}
+var _ resource.ResourceWithModifyPlan = &PersonalAccessTokenResource{}
+
+func NewPersonalAccessTokenResource() resource.Resource {
+	return &PersonalAccessTokenResource{}
+}
+
+// PersonalAccessTokenResource defines the resource implementation.
+type PersonalAccessTokenResource struct {
+	client *Client
+}
+
+// PersonalAccessTokenResourceModel describes the resource data model.
+type PersonalAccessTokenResourceModel struct {
+	Name           types.String `tfsdk:"name"`
+	TTL            types.String `tfsdk:"ttl"`
+	RotateBefore   types.String `tfsdk:"rotate_before"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	Token          types.String `tfsdk:"token"`
+	Status         types.String `tfsdk:"status"`
+	Id             types.String `tfsdk:"id"`
+}
+
+// personalAccessTokenActive is the status of a personal access token that can be used.
+const personalAccessTokenActive = "active"
+
+func (r *PersonalAccessTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_personal_access_token"
+}
+
+func (r *PersonalAccessTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Personal access token resource. The token is revoked when the resource is destroyed.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.StringAttribute{
+				MarkdownDescription: "Time the token is valid for after it is created, as a positive duration such as \"720h\". The token never expires if not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{positive: true},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotate_before": schema.StringAttribute{
+				MarkdownDescription: "Replace the token when it expires within this positive duration, such as \"168h\". The token is checked on every plan.",
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{positive: true},
+				},
+			},
+			"expiration_date": schema.StringAttribute{
+				MarkdownDescription: "Expiration date",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Token value",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Personal access token identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PersonalAccessTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PersonalAccessTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *PersonalAccessTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokens, maxTokens, err := r.client.ListPersonalAccessTokens()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read personal access tokens, got error: %s", err))
+		return
+	}
+	active := 0
+	for _, token := range tokens {
+		if status, _ := token["status"].(string); status == personalAccessTokenActive {
+			active++
+		}
+	}
+	if maxTokens > 0 && active >= maxTokens {
+		resp.Diagnostics.AddError(
+			"Personal Access Token Limit Reached",
+			fmt.Sprintf("Unable to create personal access token, the user already has %d of a maximum of %d active tokens", active, maxTokens),
+		)
+		return
+	}
+
+	expirationDate := ""
+	if !data.TTL.IsNull() {
+		ttl, err := time.ParseDuration(data.TTL.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ttl"), "Invalid TTL", fmt.Sprintf("Unable to parse ttl, got error: %s", err))
+			return
+		}
+		expirationDate = time.Now().Add(ttl).UTC().Format(time.RFC3339)
+	}
+
+	token, err := r.client.NewPersonalAccessToken(data.Name.ValueString(), expirationDate)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create personal access token, got error: %s", err))
+		return
+	}
+	id, _ := token["id"].(string)
+	value, _ := token["token"].(string)
+	data.Id = types.StringValue(id)
+	data.Token = types.StringValue(value)
+	data.refresh(token)
+	tflog.Trace(ctx, "created personal access token")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersonalAccessTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *PersonalAccessTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokens, _, err := r.client.ListPersonalAccessTokens()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read personal access tokens, got error: %s", err))
+		return
+	}
+	for _, token := range tokens {
+		if id, _ := token["id"].(string); id == data.Id.ValueString() {
+			data.refresh(token)
+			tflog.Trace(ctx, "read personal access token")
+
+			// Save updated data into Terraform state
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "personal access token not found, removing from state")
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *PersonalAccessTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *PersonalAccessTokenResourceModel
+
+	// Only rotate_before can change without replacing the token
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersonalAccessTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to rotate when the token is created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *PersonalAccessTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rotate := !state.Status.IsNull() && state.Status.ValueString() != personalAccessTokenActive
+	if !plan.RotateBefore.IsNull() && !plan.RotateBefore.IsUnknown() && !state.ExpirationDate.IsNull() {
+		rotateBefore, errRotate := time.ParseDuration(plan.RotateBefore.ValueString())
+		expirationDate, errExpiration := time.Parse(time.RFC3339, state.ExpirationDate.ValueString())
+		if errRotate == nil && errExpiration == nil && time.Until(expirationDate) < rotateBefore {
+			rotate = true
+		}
+	}
+	if !rotate {
+		return
+	}
+
+	// Replace the token with a new one
+	for _, attribute := range []string{"expiration_date", "token", "status", "id"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attribute), types.StringUnknown())...)
+	}
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expiration_date"))
+}
+
+func (r *PersonalAccessTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *PersonalAccessTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Expired and revoked tokens can no longer be used
+	if data.Status.ValueString() != personalAccessTokenActive {
+		return
+	}
+
+	err := r.client.RevokePersonalAccessToken(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke personal access token, got error: %s", err))
+		return
+	}
+	tflog.Trace(ctx, "revoked personal access token")
+}
+
+// refresh updates the model with the personal access token data.
+func (data *PersonalAccessTokenResourceModel) refresh(token map[string]interface{}) {
+	name, _ := token["name"].(string)
+	status, _ := token["status"].(string)
+	data.Name = types.StringValue(name)
+	data.Status = types.StringValue(status)
+	data.ExpirationDate = types.StringNull()
+	if expirationDate, _ := token["expirationDate"].(string); expirationDate != "" {
+		data.ExpirationDate = types.StringValue(expirationDate)
+	}
+}
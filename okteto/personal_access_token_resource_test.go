@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPersonalAccessTokenResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPersonalAccessTokenResourceConfig("24h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("okteto_personal_access_token.test", "id"),
+					resource.TestCheckResourceAttrSet("okteto_personal_access_token.test", "token"),
+					resource.TestCheckResourceAttrSet("okteto_personal_access_token.test", "expiration_date"),
+					resource.TestCheckResourceAttr("okteto_personal_access_token.test", "status", "active"),
+				),
+			},
+			// Rotation testing, the token expires within rotate_before so it is replaced on every plan
+			{
+				Config:             testAccPersonalAccessTokenResourceConfig("48h"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPersonalAccessTokenResourceConfig(rotateBefore string) string {
+	return fmt.Sprintf(`
+provider okteto {
+	namespace = "skyscrapr"
+}
+
+resource "okteto_personal_access_token" "test" {
+  name = "terraform-test"
+  ttl = "24h"
+  rotate_before = "%s"
+}
+`, rotateBefore)
+}
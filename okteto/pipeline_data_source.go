@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PipelineDataSource{}
+
+func NewPipelineDataSource() datasource.DataSource {
+	return &PipelineDataSource{}
+}
+
+// PipelineDataSource defines the data source implementation.
+type PipelineDataSource struct {
+	client *Client
+}
+
+// PipelineDataSourceModel describes the data source data model.
+type PipelineDataSourceModel struct {
+	Namespace   types.String              `tfsdk:"namespace"`
+	Name        types.String              `tfsdk:"name"`
+	Status      types.String              `tfsdk:"status"`
+	Repository  types.String              `tfsdk:"repository"`
+	Branch      types.String              `tfsdk:"branch"`
+	Filename    types.String              `tfsdk:"filename"`
+	Variables   map[string]string         `tfsdk:"variables"`
+	Deployments []pipelineDeploymentModel `tfsdk:"deployments"`
+	Endpoints   []string                  `tfsdk:"endpoints"`
+	Id          types.String              `tfsdk:"id"`
+}
+
+type pipelineDeploymentModel struct {
+	Name      types.String `tfsdk:"name"`
+	Status    types.String `tfsdk:"status"`
+	Replicas  types.Int64  `tfsdk:"replicas"`
+	Endpoints []string     `tfsdk:"endpoints"`
+}
+
+func (d *PipelineDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline"
+}
+
+func (d *PipelineDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Pipeline deployed in a namespace, including pipelines not managed by this configuration",
+
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace. Defaults to the provider namespace.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name",
+				Required:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status",
+				Computed:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository URL",
+				Computed:            true,
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch",
+				Computed:            true,
+			},
+			"filename": schema.StringAttribute{
+				MarkdownDescription: "Okteto manifest file",
+				Computed:            true,
+			},
+			"variables": schema.MapAttribute{
+				MarkdownDescription: "Variables the pipeline was deployed with",
+				Computed:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+			"deployments": schema.ListNestedAttribute{
+				MarkdownDescription: "Deployments",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status",
+							Computed:            true,
+						},
+						"replicas": schema.Int64Attribute{
+							MarkdownDescription: "Replicas",
+							Computed:            true,
+						},
+						"endpoints": schema.ListAttribute{
+							MarkdownDescription: "Endpoint URLs",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"endpoints": schema.ListAttribute{
+				MarkdownDescription: "Endpoint URLs of every workload of the pipeline",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Pipeline identifier, the same as the `id` of the `okteto_pipeline` resource",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *PipelineDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PipelineDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PipelineDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace := d.client.Namespace
+	if !data.Namespace.IsNull() {
+		namespace = data.Namespace.ValueString()
+	}
+
+	pipeline, err := d.client.GetPipeline(namespace, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pipeline, got error: %s", err))
+		return
+	}
+	if pipeline == nil {
+		resp.Diagnostics.AddError("Pipeline Not Found", fmt.Sprintf("No pipeline named %q was found in namespace %q", data.Name.ValueString(), namespace))
+		return
+	}
+
+	data.Namespace = types.StringValue(namespace)
+	data.Id = data.Name
+	data.Status = stringAttr(pipeline["status"])
+	data.Repository = stringAttr(pipeline["repository"])
+	data.Branch = stringAttr(pipeline["branch"])
+	data.Filename = stringAttr(pipeline["filename"])
+
+	variables, err := listData(pipeline, "variables")
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pipeline variables, got error: %s", err))
+		return
+	}
+	data.Variables = map[string]string{}
+	for _, variable := range variables {
+		name, _ := variable["name"].(string)
+		value, _ := variable["value"].(string)
+		data.Variables[name] = value
+	}
+
+	deployments, _ := pipeline["deployments"].([]map[string]interface{})
+	data.Deployments = []pipelineDeploymentModel{}
+	for _, deployment := range deployments {
+		data.Deployments = append(data.Deployments, pipelineDeploymentModel{
+			Name:      stringAttr(deployment["name"]),
+			Status:    stringAttr(deployment["status"]),
+			Replicas:  int64Attr(deployment["replicas"]),
+			Endpoints: pipelineEndpointURLs([]map[string]interface{}{deployment}),
+		})
+	}
+
+	data.Endpoints = []string{}
+	for _, kind := range WorkloadKinds {
+		workloads, _ := pipeline[kind].([]map[string]interface{})
+		data.Endpoints = append(data.Endpoints, pipelineEndpointURLs(workloads)...)
+	}
+	tflog.Trace(ctx, "read pipeline")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
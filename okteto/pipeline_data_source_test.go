@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPipelineDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccPipelineDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.okteto_pipeline.test", "id", "okteto_pipeline.test", "id"),
+					resource.TestCheckResourceAttr("data.okteto_pipeline.test", "namespace", "skyscrapr"),
+					resource.TestCheckResourceAttr("data.okteto_pipeline.test", "repository", "https://github.com/skyscrapr/okteto-pipeline-test.git"),
+					resource.TestCheckResourceAttr("data.okteto_pipeline.test", "branch", "main"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPipelineDataSourceConfig = `
+provider okteto {
+	namespace = "skyscrapr"
+}
+
+resource "okteto_pipeline" "test" {
+  name = "okteto_aws_lambda"
+  repo_url = "https://github.com/skyscrapr/okteto-pipeline-test.git"
+  branch = "main"
+}
+
+data "okteto_pipeline" "test" {
+  name = okteto_pipeline.test.name
+}
+`
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// pipelineHealthCheckModel describes the health check data model.
+type pipelineHealthCheckModel struct {
+	Path           types.String `tfsdk:"path"`
+	ExpectedStatus types.Int64  `tfsdk:"expected_status"`
+	BodyRegex      types.String `tfsdk:"body_regex"`
+}
+
+// pipelineHealthCheck holds the resolved health check for a pipeline.
+type pipelineHealthCheck struct {
+	path           string
+	expectedStatus int
+	bodyRegex      *regexp.Regexp
+}
+
+// maxHealthCheckBody limits how much of a response body is read when probing an endpoint.
+const maxHealthCheckBody = 1 << 20
+
+// healthCheck resolves the health check configured for the pipeline, applying defaults.
+// It returns nil when no health check is configured.
+func (data *pipelineResourceModel) healthCheck() (*pipelineHealthCheck, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data.HealthCheck == nil {
+		return nil, diags
+	}
+
+	check := &pipelineHealthCheck{
+		path:           data.HealthCheck.Path.ValueString(),
+		expectedStatus: http.StatusOK,
+	}
+
+	if !data.HealthCheck.ExpectedStatus.IsNull() {
+		check.expectedStatus = int(data.HealthCheck.ExpectedStatus.ValueInt64())
+	}
+
+	if !data.HealthCheck.BodyRegex.IsNull() {
+		re, err := regexp.Compile(data.HealthCheck.BodyRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("health_check").AtName("body_regex"),
+				"Invalid Body Regex",
+				fmt.Sprintf("Unable to compile body regex, got error: %s", err),
+			)
+		}
+		check.bodyRegex = re
+	}
+
+	return check, diags
+}
+
+// waitPipelineHealthy probes the pipeline endpoints until every one of them passes the health check.
+// On failure the last response seen for each endpoint is included in the error.
+func waitPipelineHealthy(ctx context.Context, timeout time.Duration, pollInterval time.Duration, client *Client, pipelineName string, check *pipelineHealthCheck) error {
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	lastResponses := map[string]string{}
+
+	err := waitUntil(ctx, timeout, pollInterval, func() (bool, error) {
+		workloads, err := client.ListWorkloads(client.Namespace, []string{"deployments"}, PipelineDeployedBy(pipelineName))
+		if err != nil {
+			return false, fmt.Errorf("couldn't get pipeline deployments. %s", err)
+		}
+		urls := pipelineEndpointURLs(workloads["deployments"])
+		if len(urls) == 0 {
+			return false, fmt.Errorf("pipeline %s publishes no deployment endpoints to run the health check against", pipelineName)
+		}
+		healthy := true
+		for _, url := range urls {
+			endpoint := strings.TrimSuffix(url, "/") + check.path
+			response, ok := check.probe(ctx, httpClient, endpoint)
+			tflog.Debug(ctx, fmt.Sprintf("Pipeline %s: endpoint %s: %s", pipelineName, endpoint, response))
+			lastResponses[endpoint] = response
+			if !ok {
+				healthy = false
+			}
+		}
+		return healthy, nil
+	})
+	if err != nil && len(lastResponses) > 0 {
+		endpoints := make([]string, 0, len(lastResponses))
+		for endpoint := range lastResponses {
+			endpoints = append(endpoints, endpoint)
+		}
+		sort.Strings(endpoints)
+
+		var b strings.Builder
+		fmt.Fprintf(&b, "%s\nLast responses:", err)
+		for _, endpoint := range endpoints {
+			fmt.Fprintf(&b, "\n  %s: %s", endpoint, lastResponses[endpoint])
+		}
+		return fmt.Errorf("%s", b.String())
+	}
+	return err
+}
+
+// probe sends a single request to the endpoint and reports whether it passed the health check,
+// along with a short description of the response.
+func (check *pipelineHealthCheck) probe(ctx context.Context, httpClient *http.Client, endpoint string) (string, bool) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Sprintf("error: %s", err), false
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Sprintf("error: %s", err), false
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxHealthCheckBody))
+	if err != nil {
+		return fmt.Sprintf("status %d, error reading body: %s", resp.StatusCode, err), false
+	}
+
+	summary := fmt.Sprintf("status %d, body: %q", resp.StatusCode, truncate(string(body), 200))
+	if resp.StatusCode != check.expectedStatus {
+		return summary, false
+	}
+	if check.bodyRegex != nil && !check.bodyRegex.Match(body) {
+		return summary, false
+	}
+	return summary, true
+}
+
+// pipelineEndpointURLs returns the endpoint URLs published by the pipeline deployments.
+func pipelineEndpointURLs(deployments []map[string]interface{}) []string {
+	urls := []string{}
+	for _, d := range deployments {
+		endpoints, _ := d["endpoints"].([]interface{})
+		for _, e := range endpoints {
+			endpoint, _ := e.(map[string]interface{})
+			if url, _ := endpoint["url"].(string); url != "" {
+				urls = append(urls, url)
+			}
+		}
+	}
+	return urls
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
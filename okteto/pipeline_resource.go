@@ -5,17 +5,26 @@ package okteto
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -24,6 +33,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &PipelineResource{}
 var _ resource.ResourceWithImportState = &PipelineResource{}
+var _ resource.ResourceWithValidateConfig = &PipelineResource{}
 
 func NewPipelineResource() resource.Resource {
 	return &PipelineResource{}
@@ -36,13 +46,46 @@ type PipelineResource struct {
 
 // PipelineResourceModel describes the resource data model.
 type pipelineResourceModel struct {
-	Status      types.String   `tfsdk:"status"`
-	Branch      types.String   `tfsdk:"branch"`
-	RepoURL     types.String   `tfsdk:"repo_url"`
-	Name        types.String   `tfsdk:"name"`
-	Id          types.String   `tfsdk:"id"`
-	Timeouts    timeouts.Value `tfsdk:"timeouts"`
-	Deployments types.Set      `tfsdk:"deployments"`
+	Status        types.String              `tfsdk:"status"`
+	Branch        types.String              `tfsdk:"branch"`
+	RepoURL       types.String              `tfsdk:"repo_url"`
+	Name          types.String              `tfsdk:"name"`
+	Id            types.String              `tfsdk:"id"`
+	Timeouts      timeouts.Value            `tfsdk:"timeouts"`
+	Deployments   types.Set                 `tfsdk:"deployments"`
+	Externals     types.List                `tfsdk:"externals"`
+	WaitFor       types.Set                 `tfsdk:"wait_for"`
+	Wait          *pipelineWaitModel        `tfsdk:"wait"`
+	HealthCheck   *pipelineHealthCheckModel `tfsdk:"health_check"`
+	ErrorLogLines types.Int64               `tfsdk:"error_log_lines"`
+}
+
+// defaultErrorLogLines is the number of action log lines included in a failed pipeline diagnostic.
+const defaultErrorLogLines = 50
+
+// errPipelineFailed is returned when a pipeline reaches its error state.
+var errPipelineFailed = errors.New("pipeline failed")
+
+// pipelineWaitModel describes the wait strategy data model.
+type pipelineWaitModel struct {
+	Mode                types.String `tfsdk:"mode"`
+	PollInterval        types.String `tfsdk:"poll_interval"`
+	MinReadyDeployments types.Int64  `tfsdk:"min_ready_deployments"`
+}
+
+const (
+	waitModeNone      = "none"
+	waitModePipeline  = "pipeline"
+	waitModeWorkloads = "workloads"
+	waitModeEndpoints = "endpoints"
+)
+
+// pipelineWaitOptions holds the resolved wait strategy for a pipeline.
+type pipelineWaitOptions struct {
+	mode                string
+	pollInterval        time.Duration
+	minReadyDeployments int
+	kinds               []string
 }
 
 func (r *PipelineResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -62,6 +105,9 @@ func (r *PipelineResource) Schema(ctx context.Context, req resource.SchemaReques
 			"branch": schema.StringAttribute{
 				MarkdownDescription: "Branch",
 				Required:            true,
+				Validators: []validator.String{
+					gitRefValidator{},
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -69,6 +115,9 @@ func (r *PipelineResource) Schema(ctx context.Context, req resource.SchemaReques
 			"repo_url": schema.StringAttribute{
 				MarkdownDescription: "RepoURL",
 				Required:            true,
+				Validators: []validator.String{
+					repoURLValidator{},
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -76,6 +125,10 @@ func (r *PipelineResource) Schema(ctx context.Context, req resource.SchemaReques
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, maxResourceNameLength),
+					stringvalidator.RegexMatches(pipelineNameRegex, "must start and end with a letter or digit and contain only letters, digits, \"-\", \"_\" and \".\""),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -98,16 +151,141 @@ func (r *PipelineResource) Schema(ctx context.Context, req resource.SchemaReques
 					},
 				},
 			},
+			"externals": schema.ListNestedAttribute{
+				MarkdownDescription: "External resources declared by the pipeline manifest",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name",
+							Computed:            true,
+						},
+						"icon": schema.StringAttribute{
+							MarkdownDescription: "Icon",
+							Computed:            true,
+						},
+						"endpoints": schema.ListAttribute{
+							MarkdownDescription: "Endpoint URLs",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"notes": schema.ListNestedAttribute{
+							MarkdownDescription: "Notes",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"path": schema.StringAttribute{
+										MarkdownDescription: "Path of the notes file in the repository",
+										Computed:            true,
+									},
+									"markdown": schema.StringAttribute{
+										MarkdownDescription: "Notes, in Markdown",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"error_log_lines": schema.Int64Attribute{
+				MarkdownDescription: "Number of lines of the pipeline action log included in the error when a deploy or destroy fails. Set to 0 to disable. Defaults to 50.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultErrorLogLines),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"wait_for": schema.SetAttribute{
+				MarkdownDescription: "Workload kinds that must be ready before the pipeline is considered deployed. Valid values are `deployments`, `statefulsets`, `jobs` and `functions`. Defaults to all of them.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, workloadKindValues())),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(WorkloadKinds...)),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
+				Update: true,
 				Delete: true,
 			}),
+			"health_check": schema.SingleNestedBlock{
+				MarkdownDescription: "HTTP health check run against the pipeline endpoints before the pipeline is considered created or redeployed. It fails if the pipeline publishes no deployment endpoints and can't be used with a `none` wait mode.",
+				Attributes: map[string]schema.Attribute{
+					"path": schema.StringAttribute{
+						MarkdownDescription: "Path appended to each endpoint URL, such as \"/healthz\"",
+						Optional:            true,
+					},
+					"expected_status": schema.Int64Attribute{
+						MarkdownDescription: "HTTP status code a healthy endpoint returns. Defaults to 200.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(100, 599),
+						},
+					},
+					"body_regex": schema.StringAttribute{
+						MarkdownDescription: "Regular expression the response body of a healthy endpoint matches",
+						Optional:            true,
+						Validators: []validator.String{
+							regexValidator{},
+						},
+					},
+				},
+			},
+			"wait": schema.SingleNestedBlock{
+				MarkdownDescription: "Wait strategy used when the pipeline is created, or redeployed after its repository or branch change",
+				Attributes: map[string]schema.Attribute{
+					"mode": schema.StringAttribute{
+						MarkdownDescription: "What to wait for before the pipeline is considered created or redeployed. `none` returns as soon as the deploy is accepted, `pipeline` waits for the pipeline to be deployed, `workloads` also waits for its workloads to be ready and `endpoints` also waits for its endpoints to be published. Defaults to `workloads`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(waitModeNone, waitModePipeline, waitModeWorkloads, waitModeEndpoints),
+						},
+					},
+					"poll_interval": schema.StringAttribute{
+						MarkdownDescription: "Interval between status checks, as a duration such as \"5s\" or \"1m\". Defaults to an exponential backoff of up to 10 seconds.",
+						Optional:            true,
+						Validators: []validator.String{
+							durationValidator{},
+						},
+					},
+					"min_ready_deployments": schema.Int64Attribute{
+						MarkdownDescription: "Number of deployments that must be ready for the workloads to be considered ready, capped at the number of deployments of the pipeline. Defaults to all deployments.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// ValidateConfig rejects a health check that would never run because the wait strategy does not wait for the pipeline.
+func (r *PipelineResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data pipelineResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.HealthCheck != nil && data.Wait != nil && data.Wait.Mode.ValueString() == waitModeNone {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("health_check"),
+			"Invalid Health Check",
+			fmt.Sprintf("A health check can't be used when wait.mode is %q, as the pipeline is not waited for.", waitModeNone),
+		)
+	}
+}
+
 func (r *PipelineResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -146,10 +324,22 @@ func (r *PipelineResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	wait, diags := data.waitOptions(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	healthCheck, diags := data.healthCheck()
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, createTimeout)
 	defer cancel()
 
-	err := r.client.NewPipeline(r.client.Namespace, data.Name.ValueString(), data.RepoURL.ValueString(), data.Branch.ValueString())
+	action, err := r.client.NewPipeline(r.client.Namespace, data.Name.ValueString(), data.RepoURL.ValueString(), data.Branch.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create pipeline, got error: %s", err))
 		return
@@ -160,21 +350,15 @@ func (r *PipelineResource) Create(ctx context.Context, req resource.CreateReques
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-	err = waitPipelineState(ctx, createTimeout, r.client, data.Name.ValueString(), "error", "deployed")
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to wait for pipeline state to be %s, got error: %s", data.Name.ValueString(), err))
-		return
-	}
 
-	err = waitDeploymentStates(ctx, createTimeout, r.client, data.Name.ValueString(), "error", "running")
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to wait for pipeline state to be %s, got error: %s", data.Name.ValueString(), err))
+	resp.Diagnostics.Append(r.waitPipelineDeployed(ctx, createTimeout, data, action, wait, healthCheck)...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(data.refresh(ctx, r.client)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-
 }
 
 func (r *PipelineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -192,7 +376,53 @@ func (r *PipelineResource) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *PipelineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *pipelineResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The pipeline is redeployed in place when its repository or branch change, other settings are only used while waiting
+	if !data.RepoURL.Equal(state.RepoURL) || !data.Branch.Equal(state.Branch) {
+		updateTimeout, diags := data.Timeouts.Update(ctx, 20*time.Minute)
+
+		resp.Diagnostics.Append(diags...)
+
+		wait, diags := data.waitOptions(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		healthCheck, diags := data.healthCheck()
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+		defer cancel()
+
+		action, err := r.client.NewPipeline(r.client.Namespace, data.Name.ValueString(), data.RepoURL.ValueString(), data.Branch.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to redeploy pipeline, got error: %s", err))
+			return
+		}
+		tflog.Trace(ctx, "redeployed pipeline")
+
+		resp.Diagnostics.Append(r.waitPipelineDeployed(ctx, updateTimeout, data, action, wait, healthCheck)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
+	resp.Diagnostics.Append(data.refresh(ctx, r.client)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *PipelineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -213,15 +443,23 @@ func (r *PipelineResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	wait, diags := data.waitOptions(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
 	defer cancel()
 
 	tflog.Info(ctx, "Destroying pipeline...")
-	err := destroyPipeline(ctx, r.client, deleteTimeout, data.Name.ValueString(), false)
+	err := destroyPipeline(ctx, r.client, deleteTimeout, wait.pollInterval, data.errorLogLines(), data.Name.ValueString(), false)
 	if err != nil {
 		tflog.Info(ctx, fmt.Sprintf("Unable to destroy pipeline, got error: %s", err))
 		tflog.Info(ctx, "Destroying pipeline with prejudice...")
-		err = destroyPipeline(ctx, r.client, deleteTimeout, data.Name.ValueString(), true)
+		err = destroyPipeline(ctx, r.client, deleteTimeout, wait.pollInterval, data.errorLogLines(), data.Name.ValueString(), true)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to force destroy pipeline, got error: %s", err))
 			return
@@ -230,79 +468,308 @@ func (r *PipelineResource) Delete(ctx context.Context, req resource.DeleteReques
 	tflog.Trace(ctx, "destroyed pipeline")
 }
 
+// waitPipelineDeployed waits for the action deploying the pipeline to finish, following the wait strategy,
+// and for its endpoints to pass the health check if one is configured.
+func (r *PipelineResource) waitPipelineDeployed(ctx context.Context, timeout time.Duration, data *pipelineResourceModel, action map[string]interface{}, wait pipelineWaitOptions, healthCheck *pipelineHealthCheck) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var err error
+
+	if wait.mode != waitModeNone {
+		err = waitPipelineAction(ctx, timeout, wait.pollInterval, r.client, data.Name.ValueString(), action, "error", "deployed")
+		if err != nil {
+			err = withActionLogs(err, r.client, data.Name.ValueString(), action, data.errorLogLines())
+			diags.AddError("Client Error", fmt.Sprintf("Unable to wait for pipeline state to be %s, got error: %s", data.Name.ValueString(), err))
+			return diags
+		}
+	}
+
+	if wait.mode == waitModeWorkloads || wait.mode == waitModeEndpoints {
+		err = waitWorkloadStates(ctx, timeout, r.client, data.Name.ValueString(), wait)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to wait for pipeline state to be %s, got error: %s", data.Name.ValueString(), err))
+			return diags
+		}
+	}
+
+	if wait.mode == waitModeEndpoints {
+		err = waitPipelineEndpoints(ctx, timeout, wait.pollInterval, r.client, data.Name.ValueString())
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to wait for pipeline %s endpoints, got error: %s", data.Name.ValueString(), err))
+			return diags
+		}
+	}
+
+	if healthCheck != nil {
+		err = waitPipelineHealthy(ctx, timeout, wait.pollInterval, r.client, data.Name.ValueString(), healthCheck)
+		if err != nil {
+			diags.AddError("Health Check Failed", fmt.Sprintf("Pipeline %s endpoints did not pass the health check, got error: %s", data.Name.ValueString(), err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
 func (r *PipelineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func destroyPipeline(ctx context.Context, client *Client, timeout time.Duration, pipelineName string, force bool) error {
-	err := client.DestroyPipeline(pipelineName, client.Namespace, force)
+func destroyPipeline(ctx context.Context, client *Client, timeout time.Duration, pollInterval time.Duration, logLines int, pipelineName string, force bool) error {
+	action, err := client.DestroyPipeline(pipelineName, client.Namespace, force)
 	if err == nil {
 		tflog.Info(ctx, "Waiting for pipeline to be destroyed...")
-		err = waitPipelineState(ctx, timeout, client, pipelineName, "destroy-error", "destroyed")
+		err = waitPipelineAction(ctx, timeout, pollInterval, client, pipelineName, action, "destroy-error", "destroyed")
+		err = withActionLogs(err, client, pipelineName, action, logLines)
 	}
 	return err
 }
 
-func waitPipelineState(ctx context.Context, timeout time.Duration, client *Client, pipelineName string, errorState string, successState string) error {
-	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		status, err := getPipelineState(client, pipelineName)
-		if err == nil {
-			switch status {
-			case errorState:
-				return retry.NonRetryableError(fmt.Errorf("pipeline failed. %s", status))
-			case successState, "":
-				return nil
-			default:
-				return retry.RetryableError(fmt.Errorf("expected instance to be created but was in state %s", status))
+// withActionLogs appends the last lines of the pipeline action log to err when it reports a failed pipeline.
+// The latest action of the pipeline is used when the action is unknown.
+func withActionLogs(err error, client *Client, pipelineName string, action map[string]interface{}, lines int) error {
+	if !errors.Is(err, errPipelineFailed) || lines == 0 {
+		return err
+	}
+	actionName, _ := action["name"].(string)
+	if actionName == "" {
+		pipeline, _ := client.GetGitDeploy(client.Namespace, pipelineName)
+		actionName, _ = pipeline["actionName"].(string)
+	}
+	logs, logsErr := client.GetPipelineLogs(client.Namespace, pipelineName, actionName, lines)
+	if logsErr != nil {
+		return fmt.Errorf("%w\nUnable to get pipeline logs, got error: %s", err, logsErr)
+	}
+	if len(logs) == 0 {
+		return err
+	}
+	return fmt.Errorf("%w\nLast %d lines of the pipeline logs:\n%s", err, len(logs), strings.Join(logs, "\n"))
+}
+
+// waitUntil calls check every pollInterval until it reports done, returns an error or the timeout expires.
+// A zero pollInterval backs off exponentially between checks instead.
+func waitUntil(ctx context.Context, timeout time.Duration, pollInterval time.Duration, check func() (bool, error)) error {
+	conf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			done, err := check()
+			if err != nil {
+				return nil, "", err
 			}
+			if done {
+				return done, "done", nil
+			}
+			return done, "pending", nil
+		},
+		Timeout:      timeout,
+		PollInterval: pollInterval,
+	}
+	_, err := conf.WaitForStateContext(ctx)
+	return err
+}
+
+// waitPipelineAction waits for the action that deploys or destroys the pipeline to finish.
+// When the action is unknown it falls back to polling the pipeline state by name.
+func waitPipelineAction(ctx context.Context, timeout time.Duration, pollInterval time.Duration, client *Client, pipelineName string, action map[string]interface{}, errorState string, successState string) error {
+	actionName, _ := action["name"].(string)
+	if actionName == "" {
+		return waitPipelineState(ctx, timeout, pollInterval, client, pipelineName, errorState, successState)
+	}
+	actionID, _ := action["id"].(string)
+	return waitUntil(ctx, timeout, pollInterval, func() (bool, error) {
+		current, err := client.GetAction(client.Namespace, actionName)
+		if err != nil {
+			return false, fmt.Errorf("couldn't get pipeline action. %s", err)
+		}
+		status, _ := current["status"].(string)
+		tflog.Debug(ctx, fmt.Sprintf("Pipeline %s: action %s (%s): status: %s", pipelineName, actionName, actionID, status))
+		switch status {
+		case "success", successState:
+			return true, nil
+		case "error", errorState:
+			return false, fmt.Errorf("%w. %s", errPipelineFailed, errorState)
+		default:
+			// Keep polling while the action is queued, in progress or in a status not known yet
+			return false, nil
 		}
-		return retry.NonRetryableError(fmt.Errorf("couldn't get pipeline by name. %s", err))
 	})
 }
 
-func waitDeploymentStates(ctx context.Context, timeout time.Duration, client *Client, pipelineName string, errorState string, successState string) error {
-	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		pipeline, err := client.GetPipeline(client.Namespace, pipelineName)
+func waitPipelineState(ctx context.Context, timeout time.Duration, pollInterval time.Duration, client *Client, pipelineName string, errorState string, successState string) error {
+	return waitUntil(ctx, timeout, pollInterval, func() (bool, error) {
+		status, err := getPipelineState(client, pipelineName)
 		if err != nil {
-			fmt.Printf("waitDeploymentStates: error getting pipelin: %s \n", err)
+			return false, fmt.Errorf("couldn't get pipeline by name. %s", err)
 		}
-		if pipeline != nil {
-			deployments, ok := pipeline["deployments"].([]map[string]interface{})
-			if !ok {
-				return retry.NonRetryableError(fmt.Errorf("could not get deployments data"))
-			}
-			for _, deployment := range deployments {
-				name, ok := deployment["name"].(string)
-				if !ok {
-					return retry.NonRetryableError(fmt.Errorf("could not get deployment name"))
-				}
-				status, ok := deployment["status"].(string)
+		switch status {
+		case errorState:
+			return false, fmt.Errorf("%w. %s", errPipelineFailed, status)
+		case successState, "":
+			return true, nil
+		default:
+			return false, nil
+		}
+	})
+}
+
+func waitWorkloadStates(ctx context.Context, timeout time.Duration, client *Client, pipelineName string, wait pipelineWaitOptions) error {
+	// Errors listing the workloads may be transient, the last one is reported if the wait times out
+	var lastErr error
+	err := waitUntil(ctx, timeout, wait.pollInterval, func() (bool, error) {
+		pipelineWorkloads, err := client.ListWorkloads(client.Namespace, append(wait.kinds, "pods"), PipelineDeployedBy(pipelineName))
+		if err != nil {
+			tflog.Debug(ctx, fmt.Sprintf("Pipeline %s: error getting workloads: %s", pipelineName, err))
+			lastErr = err
+			return false, nil
+		}
+		lastErr = nil
+		ready := true
+		failures := []workloadFailure{}
+		for _, kind := range wait.kinds {
+			workloads := pipelineWorkloads[kind]
+			readyCount := 0
+			for _, workload := range workloads {
+				name, ok := workload["name"].(string)
 				if !ok {
-					return retry.NonRetryableError(fmt.Errorf("could not get deployment state"))
+					return false, fmt.Errorf("could not get %s name", kind)
 				}
-				fmt.Printf("Pipeline %s: Deployment %s: status: %s\n", pipelineName, name, status)
-			}
-			for _, deployment := range deployments {
-				status, ok := deployment["status"].(string)
+				status, ok := workload["status"].(string)
 				if !ok {
-					return retry.NonRetryableError(fmt.Errorf("could not get deployment state"))
+					return false, fmt.Errorf("could not get %s state", kind)
 				}
+				tflog.Debug(ctx, fmt.Sprintf("Pipeline %s: %s %s: status: %s", pipelineName, kind, name, status))
 
-				switch deployment["status"] {
-				case errorState:
-					return retry.NonRetryableError(fmt.Errorf("pipeline deployment failed. %s", status))
-				case successState:
-				default:
-					return retry.RetryableError(fmt.Errorf("retryable state: %s", status))
+				switch workloadReadiness(kind, status) {
+				case workloadFailed:
+					failures = append(failures, workloadFailure{kind: kind, workload: workload})
+				case workloadReady:
+					readyCount++
 				}
 			}
+			// A pipeline with fewer deployments than the minimum needs all of them ready
+			required := len(workloads)
+			if kind == "deployments" && wait.minReadyDeployments > 0 && wait.minReadyDeployments < required {
+				required = wait.minReadyDeployments
+			}
+			if readyCount < required {
+				ready = false
+			}
+		}
+		if len(failures) > 0 {
+			return false, fmt.Errorf("pipeline workloads failed:\n%s", describeWorkloadFailures(failures, pipelineWorkloads["pods"]))
 		}
-		return nil
+		return ready, nil
 	})
+	if err != nil && lastErr != nil {
+		return fmt.Errorf("%w. Last error getting the pipeline workloads: %s", err, lastErr)
+	}
+	return err
+}
+
+// workloadFailure identifies a workload found in a failed state.
+type workloadFailure struct {
+	kind     string
+	workload map[string]interface{}
+}
+
+// describeWorkloadFailures renders the failed workloads, their error messages and
+// the status of their pods as a multi-line report.
+func describeWorkloadFailures(failures []workloadFailure, pods []map[string]interface{}) string {
+	var b strings.Builder
+	for i, failure := range failures {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		name, _ := failure.workload["name"].(string)
+		status, _ := failure.workload["status"].(string)
+		fmt.Fprintf(&b, "  %s %s: status: %s", strings.TrimSuffix(failure.kind, "s"), name, status)
+		if message, _ := failure.workload["error"].(string); message != "" {
+			fmt.Fprintf(&b, ", error: %s", message)
+		}
+		numPods, hasPods := failure.workload["numPods"].(float64)
+		replicas, hasReplicas := failure.workload["replicas"].(float64)
+		if hasPods && hasReplicas {
+			fmt.Fprintf(&b, " (%d/%d pods)", int(numPods), int(replicas))
+		}
+		for _, pod := range pods {
+			podName, _ := pod["name"].(string)
+			if podWorkloadName(failure.kind, podName) != name {
+				continue
+			}
+			podStatus, _ := pod["status"].(string)
+			fmt.Fprintf(&b, "\n    pod %s: status: %s", podName, podStatus)
+			if message, _ := pod["error"].(string); message != "" {
+				fmt.Fprintf(&b, ", error: %s", message)
+			}
+		}
+	}
+	return b.String()
+}
+
+// podNameSuffixes match the suffix Kubernetes appends to the name of a workload to name its pods:
+// the ReplicaSet hash and a random suffix for deployments, the ordinal for statefulsets and a random
+// suffix for jobs.
+var podNameSuffixes = map[string]*regexp.Regexp{
+	"deployments":  regexp.MustCompile(`-[a-z0-9]{1,10}-[a-z0-9]{5}$`),
+	"functions":    regexp.MustCompile(`-[a-z0-9]{1,10}-[a-z0-9]{5}$`),
+	"statefulsets": regexp.MustCompile(`-[0-9]+$`),
+	"jobs":         regexp.MustCompile(`-[a-z0-9]{5}$`),
+}
+
+// podWorkloadName returns the name of the workload of the given kind that owns the pod, or an empty
+// string when the pod name doesn't follow the naming of the kind.
+func podWorkloadName(kind string, podName string) string {
+	suffix, ok := podNameSuffixes[kind]
+	if !ok {
+		return ""
+	}
+	loc := suffix.FindStringIndex(podName)
+	if loc == nil {
+		return ""
+	}
+	return podName[:loc[0]]
+}
+
+// waitPipelineEndpoints waits until the pipeline deployments publish at least one endpoint.
+func waitPipelineEndpoints(ctx context.Context, timeout time.Duration, pollInterval time.Duration, client *Client, pipelineName string) error {
+	return waitUntil(ctx, timeout, pollInterval, func() (bool, error) {
+		workloads, err := client.ListWorkloads(client.Namespace, []string{"deployments"}, PipelineDeployedBy(pipelineName))
+		if err != nil {
+			return false, fmt.Errorf("couldn't get pipeline deployments. %s", err)
+		}
+		return len(pipelineEndpointURLs(workloads["deployments"])) > 0, nil
+	})
+}
+
+const (
+	workloadPending = iota
+	workloadReady
+	workloadFailed
+)
+
+// workloadReadiness classifies the status of a workload of the given kind.
+// Jobs are ready once they complete, every other kind once it is running.
+func workloadReadiness(kind string, status string) int {
+	switch {
+	case status == "error", kind == "jobs" && status == "failed":
+		return workloadFailed
+	case kind == "jobs" && status == "completed", kind != "jobs" && status == "running":
+		return workloadReady
+	default:
+		return workloadPending
+	}
+}
+
+func workloadKindValues() []attr.Value {
+	values := make([]attr.Value, 0, len(WorkloadKinds))
+	for _, kind := range WorkloadKinds {
+		values = append(values, types.StringValue(kind))
+	}
+	return values
 }
 
 func getPipelineState(client *Client, pipelineName string) (string, error) {
-	pipeline, err := client.GetPipeline(client.Namespace, pipelineName)
+	pipeline, err := client.GetGitDeploy(client.Namespace, pipelineName)
 	status := ""
 	if err == nil && pipeline != nil {
 		status, _ = pipeline["status"].(string)
@@ -343,18 +810,137 @@ func flattenDeployments(ctx context.Context, set interface{}) (types.Set, diag.D
 	return types.SetValueMust(elemType, attrs), diags
 }
 
+// externalNoteAttributeTypes and externalAttributeTypes describe an external resource declared by a manifest.
+var externalNoteAttributeTypes = map[string]attr.Type{
+	"path":     types.StringType,
+	"markdown": types.StringType,
+}
+
+var externalAttributeTypes = map[string]attr.Type{
+	"name":      types.StringType,
+	"icon":      types.StringType,
+	"endpoints": types.ListType{ElemType: types.StringType},
+	"notes":     types.ListType{ElemType: types.ObjectType{AttrTypes: externalNoteAttributeTypes}},
+}
+
+// flattenExternals converts the external resources of a space to a list of objects.
+func flattenExternals(ctx context.Context, externals []map[string]interface{}) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elemType := types.ObjectType{AttrTypes: externalAttributeTypes}
+	attrs := make([]attr.Value, 0, len(externals))
+	for _, e := range externals {
+		endpoints, d := types.ListValueFrom(ctx, types.StringType, pipelineEndpointURLs([]map[string]interface{}{e}))
+		diags.Append(d...)
+
+		notes, err := listData(e, "notes")
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Could not get external resource notes, got error: %s", err))
+			return types.ListNull(elemType), diags
+		}
+		noteValues := make([]attr.Value, 0, len(notes))
+		for _, n := range notes {
+			noteValues = append(noteValues, types.ObjectValueMust(externalNoteAttributeTypes, map[string]attr.Value{
+				"path":     stringAttr(n["path"]),
+				"markdown": stringAttr(n["markdown"]),
+			}))
+		}
+
+		attrs = append(attrs, types.ObjectValueMust(externalAttributeTypes, map[string]attr.Value{
+			"name":      stringAttr(e["name"]),
+			"icon":      stringAttr(e["icon"]),
+			"endpoints": endpoints,
+			"notes":     types.ListValueMust(types.ObjectType{AttrTypes: externalNoteAttributeTypes}, noteValues),
+		}))
+	}
+
+	return types.ListValueMust(elemType, attrs), diags
+}
+
+func (data *pipelineResourceModel) errorLogLines() int {
+	if data.ErrorLogLines.IsNull() || data.ErrorLogLines.IsUnknown() {
+		return defaultErrorLogLines
+	}
+	return int(data.ErrorLogLines.ValueInt64())
+}
+
+// waitOptions resolves the wait strategy configured for the pipeline, applying defaults.
+func (data *pipelineResourceModel) waitOptions(ctx context.Context) (pipelineWaitOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	wait := pipelineWaitOptions{
+		mode:  waitModeWorkloads,
+		kinds: WorkloadKinds,
+	}
+
+	if !data.WaitFor.IsNull() && !data.WaitFor.IsUnknown() {
+		diags.Append(data.WaitFor.ElementsAs(ctx, &wait.kinds, false)...)
+	}
+
+	if data.Wait == nil {
+		return wait, diags
+	}
+
+	if !data.Wait.Mode.IsNull() {
+		wait.mode = data.Wait.Mode.ValueString()
+	}
+
+	if !data.Wait.PollInterval.IsNull() {
+		pollInterval, err := time.ParseDuration(data.Wait.PollInterval.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("wait").AtName("poll_interval"),
+				"Invalid Poll Interval",
+				fmt.Sprintf("Unable to parse poll interval, got error: %s", err),
+			)
+		}
+		wait.pollInterval = pollInterval
+	}
+
+	if !data.Wait.MinReadyDeployments.IsNull() {
+		wait.minReadyDeployments = int(data.Wait.MinReadyDeployments.ValueInt64())
+	}
+
+	return wait, diags
+}
+
 func (data *pipelineResourceModel) refresh(ctx context.Context, client *Client) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	pipeline, err := client.GetPipeline(client.Namespace, data.Name.ValueString())
+	pipeline, err := client.GetGitDeploy(client.Namespace, data.Name.ValueString())
 	if err != nil {
 		diags.AddError("Client Error", fmt.Sprintf("Unable to get pipeline, got error: %s", err))
 		return diags
 	}
 
+	workloads, err := client.ListWorkloads(client.Namespace, []string{"deployments", "externals"}, PipelineDeployedBy(data.Name.ValueString()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get pipeline deployments, got error: %s", err))
+		return diags
+	}
+
+	collisions, err := client.PipelineNameCollisions(client.Namespace, data.Name.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list pipelines, got error: %s", err))
+		return diags
+	}
+	if len(collisions) > 0 {
+		diags.AddWarning(
+			"Pipeline Name Collision",
+			fmt.Sprintf("The workloads of pipeline %s can't be told apart from the workloads of pipelines %s, as they are all deployed by %q. "+
+				"The pipeline deployments may include workloads of the other pipelines.",
+				data.Name.ValueString(), strings.Join(collisions, ", "), PipelineDeployedBy(data.Name.ValueString())),
+		)
+	}
+
 	v, _ := pipeline["status"].(string)
 	data.Status = types.StringValue(v)
-	data.Deployments, diags = flattenDeployments(ctx, pipeline["deployments"])
+	deployments, deploymentDiags := flattenDeployments(ctx, workloads["deployments"])
+	diags.Append(deploymentDiags...)
+	data.Deployments = deployments
+	externals, externalDiags := flattenExternals(ctx, workloads["externals"])
+	diags.Append(externalDiags...)
+	data.Externals = externals
 
 	return diags
 }
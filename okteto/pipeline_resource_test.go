@@ -4,8 +4,13 @@
 package okteto
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -23,6 +28,8 @@ func TestAccPipelineResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("okteto_pipeline.test", "name", "okteto_aws_lambda"),
 					resource.TestCheckResourceAttr("okteto_pipeline.test", "repo_url", "https://github.com/skyscrapr/okteto-pipeline-test.git"),
 					resource.TestCheckResourceAttr("okteto_pipeline.test", "branch", "main"),
+					resource.TestCheckResourceAttr("okteto_pipeline.test", "wait_for.#", "4"),
+					resource.TestCheckResourceAttr("okteto_pipeline.test", "error_log_lines", "50"),
 				),
 			},
 			// // Update and Read testing
@@ -60,6 +67,7 @@ func TestAccPipelineResource_endpoints(t *testing.T) {
 					resource.TestCheckResourceAttr("okteto_pipeline.test", "name", "okteto_aws_s3"),
 					resource.TestCheckResourceAttr("okteto_pipeline.test", "repo_url", "https://github.com/skyscrapr/oktetodo-terraform-s3.git"),
 					resource.TestCheckResourceAttr("okteto_pipeline.test", "branch", "main"),
+					resource.TestCheckResourceAttrSet("okteto_pipeline.test", "externals.#"),
 				),
 			},
 			// // Update and Read testing
@@ -76,6 +84,76 @@ func TestAccPipelineResource_endpoints(t *testing.T) {
 	})
 }
 
+func TestAccPipelineResource_waitNone(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPipelineResourceConfig_wait("main", "none", "5s"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("okteto_pipeline.test", "id"),
+					resource.TestCheckResourceAttrSet("okteto_pipeline.test", "status"),
+					resource.TestCheckResourceAttr("okteto_pipeline.test", "wait.mode", "none"),
+					resource.TestCheckResourceAttr("okteto_pipeline.test", "wait.poll_interval", "5s"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccPipelineResourceConfig_wait("main", "none", "10s"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("okteto_pipeline.test", "id"),
+					resource.TestCheckResourceAttr("okteto_pipeline.test", "wait.poll_interval", "10s"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccPipelineResource_healthCheck(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"aws": {
+				Source: "hashicorp/aws",
+			},
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPipelineResourceConfig_healthCheck("main", "endpoints"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("okteto_pipeline.test", "id"),
+					resource.TestCheckResourceAttr("okteto_pipeline.test", "health_check.expected_status", "200"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccPipelineResource_healthCheckWaitNone(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"aws": {
+				Source: "hashicorp/aws",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPipelineResourceConfig_healthCheck("main", "none"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Health Check"),
+			},
+		},
+	})
+}
+
 func TestAccPipelineResourceFailedDestroy(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -136,6 +214,25 @@ resource "okteto_pipeline" "test" {
 `, branch)
 }
 
+func testAccPipelineResourceConfig_wait(branch string, mode string, pollInterval string) string {
+	return fmt.Sprintf(`
+provider okteto {
+	namespace = "skyscrapr"
+}
+
+resource "okteto_pipeline" "test" {
+  name = "okteto_aws_lambda"
+  repo_url = "https://github.com/skyscrapr/okteto-pipeline-test.git"
+  branch = "%s"
+
+  wait {
+    mode = "%s"
+    poll_interval = "%s"
+  }
+}
+`, branch, mode, pollInterval)
+}
+
 func testAccPipelineResourceConfig_endpoints(branch string) string {
 	return fmt.Sprintf(`
 provider "aws" {
@@ -154,6 +251,32 @@ resource "okteto_pipeline" "test" {
 `, branch)
 }
 
+func testAccPipelineResourceConfig_healthCheck(branch string, mode string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+	region = "us-east-1"
+}
+
+provider okteto {
+	namespace = "skyscrapr"
+}
+
+resource "okteto_pipeline" "test" {
+  name = "okteto_aws_s3"
+  repo_url = "https://github.com/skyscrapr/oktetodo-terraform-s3.git"
+  branch = "%s"
+
+  wait {
+    mode = "%s"
+  }
+
+  health_check {
+    expected_status = 200
+  }
+}
+`, branch, mode)
+}
+
 func testAccPipelineResourceConfig_complex(branch string) string {
 	return fmt.Sprintf(`
 provider "aws" {
@@ -230,3 +353,60 @@ resource "aws_iam_user" "okteto_deploy" {
   }
 `, branch)
 }
+
+func TestDescribeWorkloadFailures(t *testing.T) {
+	failures := []workloadFailure{
+		{kind: "deployments", workload: map[string]interface{}{"name": "api", "status": "error", "error": "CrashLoopBackOff"}},
+		{kind: "statefulsets", workload: map[string]interface{}{"name": "db", "status": "error"}},
+	}
+	pods := []map[string]interface{}{
+		{"name": "api-5d8f7c9b6-abcde", "status": "error", "error": "exit code 1"},
+		{"name": "api-worker-7d9f8b6c5-x2k4q", "status": "running"},
+		{"name": "db-0", "status": "error"},
+		{"name": "db-backup-28147320-zv9lp", "status": "completed"},
+	}
+
+	got := describeWorkloadFailures(failures, pods)
+	want := "  deployment api: status: error, error: CrashLoopBackOff\n" +
+		"    pod api-5d8f7c9b6-abcde: status: error, error: exit code 1\n" +
+		"  statefulset db: status: error\n" +
+		"    pod db-0: status: error"
+	if got != want {
+		t.Errorf("describeWorkloadFailures() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestPodWorkloadName(t *testing.T) {
+	tests := []struct {
+		kind string
+		pod  string
+		want string
+	}{
+		{kind: "deployments", pod: "api-5d8f7c9b6-abcde", want: "api"},
+		{kind: "deployments", pod: "api-worker-7d9f8b6c5-x2k4q", want: "api-worker"},
+		{kind: "statefulsets", pod: "db-0", want: "db"},
+		{kind: "statefulsets", pod: "db-replica-12", want: "db-replica"},
+		{kind: "jobs", pod: "migrate-x2k4q", want: "migrate"},
+		{kind: "deployments", pod: "api", want: ""},
+		{kind: "volumes", pod: "data-0", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pod, func(t *testing.T) {
+			if got := podWorkloadName(tt.kind, tt.pod); got != tt.want {
+				t.Errorf("podWorkloadName(%q, %q) = %q, want %q", tt.kind, tt.pod, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWaitWorkloadStatesReportsLastError(t *testing.T) {
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	wait := pipelineWaitOptions{pollInterval: 10 * time.Millisecond, kinds: []string{"deployments"}}
+
+	err := waitWorkloadStates(context.Background(), 200*time.Millisecond, c, "movies", wait)
+	if err == nil || !strings.Contains(err.Error(), "Last error getting the pipeline workloads: failed to execute query: 400 Bad Request") {
+		t.Errorf("waitWorkloadStates() error = %v, want the last error getting the workloads", err)
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PipelinesDataSource{}
+
+func NewPipelinesDataSource() datasource.DataSource {
+	return &PipelinesDataSource{}
+}
+
+// PipelinesDataSource defines the data source implementation.
+type PipelinesDataSource struct {
+	client *Client
+}
+
+// PipelinesDataSourceModel describes the data source data model.
+type PipelinesDataSourceModel struct {
+	Namespace  types.String             `tfsdk:"namespace"`
+	Status     types.String             `tfsdk:"status"`
+	Repository types.String             `tfsdk:"repository"`
+	Names      []types.String           `tfsdk:"names"`
+	Pipelines  []pipelinesPipelineModel `tfsdk:"pipelines"`
+	Id         types.String             `tfsdk:"id"`
+}
+
+type pipelinesPipelineModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Status     types.String `tfsdk:"status"`
+	Repository types.String `tfsdk:"repository"`
+	Branch     types.String `tfsdk:"branch"`
+	Filename   types.String `tfsdk:"filename"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+func (d *PipelinesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipelines"
+}
+
+func (d *PipelinesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Pipelines deployed in a namespace",
+
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace. Defaults to the provider namespace.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return pipelines with this status, such as `deployed` or `error`",
+				Optional:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Only return pipelines deployed from this repository URL",
+				Optional:            true,
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "Names of the pipelines",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"pipelines": schema.ListNestedAttribute{
+				MarkdownDescription: "Pipelines",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Pipeline identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status",
+							Computed:            true,
+						},
+						"repository": schema.StringAttribute{
+							MarkdownDescription: "Repository URL",
+							Computed:            true,
+						},
+						"branch": schema.StringAttribute{
+							MarkdownDescription: "Branch",
+							Computed:            true,
+						},
+						"filename": schema.StringAttribute{
+							MarkdownDescription: "Okteto manifest file",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Creation date",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Date of the last deploy",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *PipelinesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PipelinesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PipelinesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace := d.client.Namespace
+	if !data.Namespace.IsNull() {
+		namespace = data.Namespace.ValueString()
+	}
+
+	gitDeploys, err := d.client.ListGitDeploys(namespace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pipelines, got error: %s", err))
+		return
+	}
+
+	data.Namespace = types.StringValue(namespace)
+	data.Names = []types.String{}
+	data.Pipelines = []pipelinesPipelineModel{}
+	for _, gitDeploy := range gitDeploys {
+		status, _ := gitDeploy["status"].(string)
+		repository, _ := gitDeploy["repository"].(string)
+		if !data.Status.IsNull() && status != data.Status.ValueString() {
+			continue
+		}
+		if !data.Repository.IsNull() && repository != data.Repository.ValueString() {
+			continue
+		}
+		data.Names = append(data.Names, stringAttr(gitDeploy["name"]))
+		data.Pipelines = append(data.Pipelines, pipelinesPipelineModel{
+			Id:         stringAttr(gitDeploy["id"]),
+			Name:       stringAttr(gitDeploy["name"]),
+			Status:     stringAttr(gitDeploy["status"]),
+			Repository: stringAttr(gitDeploy["repository"]),
+			Branch:     stringAttr(gitDeploy["branch"]),
+			Filename:   stringAttr(gitDeploy["filename"]),
+			CreatedAt:  stringAttr(gitDeploy["createdAt"]),
+			UpdatedAt:  stringAttr(gitDeploy["updatedAt"]),
+		})
+	}
+	data.Id = types.StringValue(namespace)
+	tflog.Trace(ctx, "read pipelines")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
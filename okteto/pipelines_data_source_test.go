@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPipelinesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccPipelinesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okteto_pipelines.test", "namespace", "skyscrapr"),
+					resource.TestCheckResourceAttr("data.okteto_pipelines.test", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.okteto_pipelines.test", "pipelines.0.name", "okteto_aws_lambda"),
+					resource.TestCheckResourceAttr("data.okteto_pipelines.test", "pipelines.0.branch", "main"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPipelinesDataSourceConfig = `
+provider okteto {
+	namespace = "skyscrapr"
+}
+
+resource "okteto_pipeline" "test" {
+  name = "okteto_aws_lambda"
+  repo_url = "https://github.com/skyscrapr/okteto-pipeline-test.git"
+  branch = "main"
+}
+
+data "okteto_pipelines" "test" {
+  repository = okteto_pipeline.test.repo_url
+}
+`
@@ -5,13 +5,18 @@ package okteto
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -28,10 +33,15 @@ type ScaffoldingProvider struct {
 
 // ScaffoldingProviderModel describes the provider data model.
 type ScaffoldingProviderModel struct {
-	ApiToken  types.String `tfsdk:"api_token"`
-	Namespace types.String `tfsdk:"namespace"`
+	ApiToken           types.String `tfsdk:"api_token"`
+	ApiTokenName       types.String `tfsdk:"api_token_name"`
+	TokenExpiryWarning types.String `tfsdk:"token_expiry_warning"`
+	Namespace          types.String `tfsdk:"namespace"`
 }
 
+// defaultTokenExpiryWarning is how long before the API token expires a warning is shown by default.
+const defaultTokenExpiryWarning = 7 * 24 * time.Hour
+
 func (p *ScaffoldingProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "okteto"
 	resp.Version = p.version
@@ -41,8 +51,19 @@ func (p *ScaffoldingProvider) Schema(ctx context.Context, req provider.SchemaReq
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"api_token": schema.StringAttribute{
-				MarkdownDescription: "Okteto API Token - Can also be configured by setting environment variable with name 'OKTETO_API_TOKEB'",
+				MarkdownDescription: "Okteto API Token - Can also be configured by setting environment variable with name 'OKTETO_API_TOKEN'. The provider fails when the token is invalid, expired or revoked. Its expiry is only checked ahead of time, to warn before it expires, when `api_token_name` is set.",
+				Optional:            true,
+			},
+			"api_token_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the personal access token used as API token, required to warn when it is close to expiry - Can also be configured by setting environment variable with name 'OKTETO_API_TOKEN_NAME'",
+				Optional:            true,
+			},
+			"token_expiry_warning": schema.StringAttribute{
+				MarkdownDescription: "Warn when the API token expires within this duration. Only used when `api_token_name` is set. Defaults to \"168h\".",
 				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
 			},
 			"namespace": schema.StringAttribute{
 				MarkdownDescription: "Okteto Namespace",
@@ -63,6 +84,11 @@ func (p *ScaffoldingProvider) Configure(ctx context.Context, req provider.Config
 	if !data.ApiToken.IsNull() {
 		api_token = data.ApiToken.ValueString()
 	}
+	api_token_name := os.Getenv("OKTETO_API_TOKEN_NAME")
+	if !data.ApiTokenName.IsNull() {
+		api_token_name = data.ApiTokenName.ValueString()
+	}
+	token_expiry_warning := tokenExpiryWarning(data.TokenExpiryWarning)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -80,19 +106,120 @@ func (p *ScaffoldingProvider) Configure(ctx context.Context, req provider.Config
 
 	// Example client configuration for data sources and resources
 	client := NewClient(api_token, data.Namespace.ValueString())
+	if api_token != "" && !data.ApiToken.IsUnknown() {
+		resp.Diagnostics.Append(checkApiToken(client, api_token_name, token_expiry_warning)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
+// tokenExpiryWarning returns the configured token expiry warning window, or the default one when
+// it is not set or not known yet.
+func tokenExpiryWarning(value types.String) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultTokenExpiryWarning
+	}
+	warning, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return defaultTokenExpiryWarning
+	}
+	return warning
+}
+
+// checkApiToken looks up the personal access token used as API token, returning an error when it has
+// expired or been revoked and a warning when it expires within the warning window.
+func checkApiToken(client *Client, name string, warning time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tokens, _, err := client.ListPersonalAccessTokens()
+	if errors.Is(err, ErrUnauthorized) {
+		diags.AddAttributeError(path.Root("api_token"), "Invalid Okteto API Token", "The Okteto API token is invalid, expired or revoked.")
+		return diags
+	}
+	if err != nil {
+		diags.AddWarning("Unable to Check Okteto API Token", fmt.Sprintf("Unable to read personal access tokens, got error: %s", err))
+		return diags
+	}
+	if name == "" {
+		return diags
+	}
+
+	for _, token := range tokens {
+		if tokenName, _ := token["name"].(string); tokenName == name {
+			return apiTokenExpiryDiagnostics(token, warning, time.Now())
+		}
+	}
+	diags.AddAttributeWarning(
+		path.Root("api_token_name"),
+		"Okteto API Token Not Found",
+		fmt.Sprintf("No personal access token named %q was found, its expiry can't be checked.", name),
+	)
+	return diags
+}
+
+// apiTokenExpiryDiagnostics checks the status and expiration date of the personal access token used as
+// API token at the given time.
+func apiTokenExpiryDiagnostics(token map[string]interface{}, warning time.Duration, now time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	name, _ := token["name"].(string)
+	if status, _ := token["status"].(string); status != "" && status != personalAccessTokenActive {
+		diags.AddAttributeError(
+			path.Root("api_token"),
+			"Okteto API Token Not Active",
+			fmt.Sprintf("The personal access token %q used as Okteto API token is %s.", name, status),
+		)
+		return diags
+	}
+
+	expirationDate, _ := token["expirationDate"].(string)
+	if expirationDate == "" {
+		return diags
+	}
+	expires, err := time.Parse(time.RFC3339, expirationDate)
+	if err != nil {
+		return diags
+	}
+	switch {
+	case !now.Before(expires):
+		diags.AddAttributeError(
+			path.Root("api_token"),
+			"Okteto API Token Expired",
+			fmt.Sprintf("The personal access token %q used as Okteto API token expired on %s.", name, expirationDate),
+		)
+	case expires.Sub(now) < warning:
+		diags.AddAttributeWarning(
+			path.Root("api_token"),
+			"Okteto API Token Expires Soon",
+			fmt.Sprintf("The personal access token %q used as Okteto API token expires on %s, in %s.", name, expirationDate, expires.Sub(now).Round(time.Minute)),
+		)
+	}
+	return diags
+}
+
 func (p *ScaffoldingProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewSecretResource,
+		NewSecretsResource,
 		NewPipelineResource,
+		NewPersonalAccessTokenResource,
 	}
 }
 
 func (p *ScaffoldingProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewUserDataSource,
+		NewNamespaceDataSource,
+		NewNamespacesDataSource,
+		NewPipelineDataSource,
+		NewPipelinesDataSource,
+		NewEndpointsDataSource,
+		NewExternalResourcesDataSource,
+		NewWorkloadsDataSource,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -5,8 +5,10 @@ package okteto
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -23,3 +25,49 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+func TestApiTokenExpiryDiagnostics(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		token     map[string]interface{}
+		wantError bool
+		wantWarn  bool
+	}{
+		{name: "no expiration", token: map[string]interface{}{"status": "active"}},
+		{name: "far from expiry", token: map[string]interface{}{"status": "active", "expirationDate": "2023-07-01T12:00:00Z"}},
+		{name: "expires soon", token: map[string]interface{}{"status": "active", "expirationDate": "2023-06-03T12:00:00Z"}, wantWarn: true},
+		{name: "expired", token: map[string]interface{}{"status": "active", "expirationDate": "2023-06-01T11:00:00Z"}, wantError: true},
+		{name: "revoked", token: map[string]interface{}{"status": "revoked"}, wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := apiTokenExpiryDiagnostics(tt.token, 7*24*time.Hour, now)
+			if got := diags.HasError(); got != tt.wantError {
+				t.Errorf("HasError() = %v, want %v", got, tt.wantError)
+			}
+			if got := diags.WarningsCount() > 0; got != tt.wantWarn {
+				t.Errorf("has warnings = %v, want %v", got, tt.wantWarn)
+			}
+		})
+	}
+}
+
+func TestTokenExpiryWarning(t *testing.T) {
+	tests := []struct {
+		name  string
+		value types.String
+		want  time.Duration
+	}{
+		{name: "not set", value: types.StringNull(), want: defaultTokenExpiryWarning},
+		{name: "unknown", value: types.StringUnknown(), want: defaultTokenExpiryWarning},
+		{name: "set", value: types.StringValue("48h"), want: 48 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tokenExpiryWarning(tt.value); got != tt.want {
+				t.Errorf("tokenExpiryWarning() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
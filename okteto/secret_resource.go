@@ -5,13 +5,23 @@ package okteto
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"os"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -19,6 +29,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &SecretResource{}
 var _ resource.ResourceWithImportState = &SecretResource{}
+var _ resource.ResourceWithConfigValidators = &SecretResource{}
+var _ resource.ResourceWithModifyPlan = &SecretResource{}
 
 func NewSecretResource() resource.Resource {
 	return &SecretResource{}
@@ -31,11 +43,22 @@ type SecretResource struct {
 
 // SecretResourceModel describes the resource data model.
 type SecretResourceModel struct {
-	Value types.String `tfsdk:"value"`
-	Name  types.String `tfsdk:"name"`
-	Id    types.String `tfsdk:"id"`
+	Value         types.String `tfsdk:"value"`
+	ValueFromEnv  types.String `tfsdk:"value_from_env"`
+	ValueFromFile types.String `tfsdk:"value_from_file"`
+	ValueVersion  types.String `tfsdk:"value_version"`
+	ValueHash     types.String `tfsdk:"value_hash"`
+	Name          types.String `tfsdk:"name"`
+	Scope         types.String `tfsdk:"scope"`
+	Id            types.String `tfsdk:"id"`
 }
 
+const (
+	secretScopeUser      = "user"
+	secretScopeNamespace = "namespace"
+	secretScopeGlobal    = "global"
+)
+
 func (r *SecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_secret"
 }
@@ -47,9 +70,25 @@ func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest,
 
 		Attributes: map[string]schema.Attribute{
 			"value": schema.StringAttribute{
-				MarkdownDescription: "Value",
-				Required:            true,
-				// Sensitive: true,
+				MarkdownDescription: "Value. Stored in plaintext in the Terraform state, use `value_from_env` or `value_from_file` to only store a hash of the value.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"value_from_env": schema.StringAttribute{
+				MarkdownDescription: "Name of an environment variable of the provider process holding the value. Only a salted hash of the value is stored in the Terraform state.",
+				Optional:            true,
+			},
+			"value_from_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a local file holding the value, without trailing newlines. Only a salted hash of the value is stored in the Terraform state.",
+				Optional:            true,
+			},
+			"value_version": schema.StringAttribute{
+				MarkdownDescription: "Arbitrary version of the value. Changing it writes the value to Okteto again, which rotates the secret when the value can't be read at plan time.",
+				Optional:            true,
+			},
+			"value_hash": schema.StringAttribute{
+				MarkdownDescription: "Salted SHA-256 hash of the value, set when the value is read from `value_from_env` or `value_from_file`",
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -57,8 +96,23 @@ func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(secretNameRegex, "must be a valid environment variable name: letters, digits and \"_\", not starting with a digit"),
+				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Scope of the secret. `user` secrets are available to the pipelines deployed by the user, `namespace` secrets to every pipeline deployed in the provider namespace and `global` secrets, which require admin permissions, to every pipeline of the Okteto instance. Defaults to `user`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(secretScopeUser),
+				Validators: []validator.String{
+					stringvalidator.OneOf(secretScopeUser, secretScopeNamespace, secretScopeGlobal),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"id": schema.StringAttribute{
@@ -72,6 +126,16 @@ func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *SecretResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("value"),
+			path.MatchRoot("value_from_env"),
+			path.MatchRoot("value_from_file"),
+		),
+	}
+}
+
 func (r *SecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -102,12 +166,33 @@ func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	err := r.client.NewSecret(data.Name.ValueString(), data.Value.ValueString())
+	value, diags := data.secretValue()
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace := ""
+	if data.Scope.ValueString() == secretScopeNamespace {
+		namespace = r.client.Namespace
+	}
+	err := setScopedSecret(r.client, data.Scope.ValueString(), namespace, data.Name.ValueString(), value)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create secret, got error: %s", err))
 		return
 	}
-	data.Id = data.Name
+	data.Id = types.StringValue(secretID(data.Scope.ValueString(), namespace, data.Name.ValueString()))
+	data.ValueHash = types.StringNull()
+	if data.hashed() {
+		salt, err := newSalt()
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to generate salt, got error: %s", err))
+			return
+		}
+		data.ValueHash = types.StringValue(hashSecretValue(salt, value))
+	}
 	tflog.Trace(ctx, "created secret")
 
 	// Save data into Terraform state
@@ -123,18 +208,125 @@ func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	// err := r.client.GetSecret(data.Name.ValueString())
-	// if err != nil {
-	// 	resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret, got error: %s", err))
-	// 	return
-	// }
-	// tflog.Trace(ctx, "read secret")
+
+	// The secret identifier is all that is known when importing
+	scope, namespace, name := parseSecretID(data.Id.ValueString())
+	secret, err := getScopedSecret(r.client, scope, namespace, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret, got error: %s", err))
+		return
+	}
+	if secret == nil {
+		tflog.Trace(ctx, "secret not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tflog.Trace(ctx, "read secret")
+
+	value, _ := secret["value"].(string)
+	data.Name = types.StringValue(name)
+	data.Scope = types.StringValue(scope)
+	if data.hashed() {
+		// Compare hashes of the remote value to detect drift without storing the value
+		data.ValueHash = types.StringValue(hashSecretValue(hashSalt(data.ValueHash.ValueString()), value))
+	} else {
+		data.Value = types.StringValue(value)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SecretResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *SecretResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	value, diags := data.secretValue()
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Adding a secret that already exists replaces its value
+	scope, namespace, name := parseSecretID(state.Id.ValueString())
+	err := setScopedSecret(r.client, scope, namespace, name, value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret, got error: %s", err))
+		return
+	}
+	data.ValueHash = types.StringNull()
+	if data.hashed() {
+		salt := hashSalt(state.ValueHash.ValueString())
+		if salt == "" {
+			salt, err = newSalt()
+			if err != nil {
+				resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to generate salt, got error: %s", err))
+				return
+			}
+		}
+		data.ValueHash = types.StringValue(hashSecretValue(salt, value))
+	}
+	tflog.Trace(ctx, "updated secret")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the secret is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *SecretResourceModel
 
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// No hash is stored when the value is, even if one was stored for a previous value source
+	if !plan.hashed() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value_hash"), types.StringNull())...)
+		return
+	}
+
+	// Nothing to compare when the secret is created
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state *SecretResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() || state.ValueHash.IsNull() {
+		return
+	}
+
+	// The value may only be available when applying, in which case value_version triggers the update
+	value, diags := plan.secretValue()
+	if diags.HasError() {
+		return
+	}
+
+	// Plan an update when the value differs from the value the hash in state was computed from
+	if hashSecretValue(hashSalt(state.ValueHash.ValueString()), value) != state.ValueHash.ValueString() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value_hash"), types.StringUnknown())...)
+	}
 }
 
 func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -147,7 +339,8 @@ func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteSecret(data.Name.ValueString())
+	scope, namespace, name := parseSecretID(data.Id.ValueString())
+	err := deleteScopedSecret(r.client, scope, namespace, name)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete secret, got error: %s", err))
 		return
@@ -158,3 +351,133 @@ func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *SecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// hashed reports whether only a hash of the secret value is stored in state.
+func (data *SecretResourceModel) hashed() bool {
+	return !data.ValueFromEnv.IsNull() || !data.ValueFromFile.IsNull()
+}
+
+// secretValue returns the secret value from the configured source.
+func (data *SecretResourceModel) secretValue() (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch {
+	case !data.ValueFromEnv.IsNull():
+		value, ok := os.LookupEnv(data.ValueFromEnv.ValueString())
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("value_from_env"),
+				"Missing Secret Value",
+				fmt.Sprintf("Environment variable %s is not set", data.ValueFromEnv.ValueString()),
+			)
+		}
+		return value, diags
+	case !data.ValueFromFile.IsNull():
+		value, err := os.ReadFile(data.ValueFromFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("value_from_file"),
+				"Missing Secret Value",
+				fmt.Sprintf("Unable to read secret value, got error: %s", err),
+			)
+		}
+		return strings.TrimRight(string(value), "\r\n"), diags
+	default:
+		return data.Value.ValueString(), diags
+	}
+}
+
+// newSalt returns a random salt for hashing a secret value.
+func newSalt() (string, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(salt), nil
+}
+
+// hashSecretValue returns the salted SHA-256 hash of a secret value, prefixed with the salt.
+func hashSecretValue(salt string, value string) string {
+	sum := sha256.Sum256([]byte(salt + value))
+	return salt + ":" + hex.EncodeToString(sum[:])
+}
+
+// hashSalt returns the salt a secret value hash was computed with.
+func hashSalt(hash string) string {
+	salt, _, _ := strings.Cut(hash, ":")
+	return salt
+}
+
+// secretID returns the identifier of a secret. User secrets are identified by their name,
+// namespace secrets by "namespace/<namespace>/<name>" and global secrets by "global/<name>".
+func secretID(scope string, namespace string, name string) string {
+	switch scope {
+	case secretScopeNamespace:
+		return secretScopeNamespace + "/" + namespace + "/" + name
+	case secretScopeGlobal:
+		return secretScopeGlobal + "/" + name
+	default:
+		return name
+	}
+}
+
+// parseSecretID returns the scope, namespace and name of the secret with the given identifier.
+func parseSecretID(id string) (string, string, string) {
+	parts := strings.Split(id, "/")
+	switch {
+	case len(parts) == 3 && parts[0] == secretScopeNamespace:
+		return secretScopeNamespace, parts[1], parts[2]
+	case len(parts) == 2 && parts[0] == secretScopeGlobal:
+		return secretScopeGlobal, "", parts[1]
+	default:
+		return secretScopeUser, "", id
+	}
+}
+
+// setScopedSecret adds a secret in the given scope, replacing its value if it already exists.
+func setScopedSecret(client *Client, scope string, namespace string, name string, value string) error {
+	switch scope {
+	case secretScopeNamespace:
+		return client.NewNamespaceSecret(namespace, name, value)
+	case secretScopeGlobal:
+		return client.NewGlobalSecret(name, value)
+	default:
+		return client.NewSecret(name, value)
+	}
+}
+
+// getScopedSecret returns the secret with the given name in the given scope, or nil if it doesn't exist.
+func getScopedSecret(client *Client, scope string, namespace string, name string) (map[string]interface{}, error) {
+	var secrets []map[string]interface{}
+	var err error
+	switch scope {
+	case secretScopeNamespace:
+		secrets, err = client.ListNamespaceSecrets(namespace)
+	case secretScopeGlobal:
+		secrets, err = client.ListGlobalSecrets()
+	default:
+		secrets, err = client.ListSecrets()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, secret := range secrets {
+		if secretName, _ := secret["name"].(string); secretName == name {
+			return copyMap(secret), nil
+		}
+	}
+	return nil, nil
+}
+
+// deleteScopedSecret deletes the secret with the given name in the given scope.
+func deleteScopedSecret(client *Client, scope string, namespace string, name string) error {
+	switch scope {
+	case secretScopeNamespace:
+		return client.DeleteNamespaceSecret(namespace, name)
+	case secretScopeGlobal:
+		return client.DeleteGlobalSecret(name)
+	default:
+		return client.DeleteSecret(name)
+	}
+}
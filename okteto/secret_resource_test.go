@@ -4,9 +4,12 @@
 package okteto
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -24,15 +27,75 @@ func TestAccSecretResource(t *testing.T) {
 					resource.TestCheckResourceAttr("okteto_secret.test", "value", "value_one"),
 				),
 			},
-			// // Update and Read testing
-			// {
-			// 	Config: testAccExampleResourceConfig("two"),
-			// 	Check: resource.ComposeAggregateTestCheckFunc(
-			// 		resource.TestCheckResourceAttrSet("okteto_secret.test", "id"),
-			// 		resource.TestCheckResourceAttr("okteto_secret.test", "name", "test_secret"),
-			// 		resource.TestCheckResourceAttr("okteto_secret.test", "value", "value_two"),
-			// 	),
-			// },
+			// ImportState testing
+			{
+				ResourceName:      "okteto_secret.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccExampleResourceConfig("two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("okteto_secret.test", "id"),
+					resource.TestCheckResourceAttr("okteto_secret.test", "name", "test_secret"),
+					resource.TestCheckResourceAttr("okteto_secret.test", "value", "value_two"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccSecretResource_namespaceScope(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSecretResourceConfig_scope("namespace"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("okteto_secret.test", "id", "namespace/skyscrapr/test_scoped_secret"),
+					resource.TestCheckResourceAttr("okteto_secret.test", "scope", "namespace"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "okteto_secret.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccSecretResource_valueFromEnv(t *testing.T) {
+	t.Setenv("OKTETO_TEST_SECRET", "value_one")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSecretResourceConfig_valueFromEnv("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("okteto_secret.test", "name", "test_secret_from_env"),
+					resource.TestCheckNoResourceAttr("okteto_secret.test", "value"),
+					resource.TestCheckResourceAttrSet("okteto_secret.test", "value_hash"),
+				),
+			},
+			// Rotation testing
+			{
+				PreConfig: func() { t.Setenv("OKTETO_TEST_SECRET", "value_two") },
+				Config:    testAccSecretResourceConfig_valueFromEnv("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("okteto_secret.test", "value_version", "2"),
+					resource.TestCheckResourceAttrSet("okteto_secret.test", "value_hash"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -50,3 +113,104 @@ resource "okteto_secret" "test" {
 }
 `, name_suffix)
 }
+
+func testAccSecretResourceConfig_valueFromEnv(version string) string {
+	return fmt.Sprintf(`
+provider okteto {
+	namespace = "skyscrapr"
+}
+
+resource "okteto_secret" "test" {
+  name = "test_secret_from_env"
+  value_from_env = "OKTETO_TEST_SECRET"
+  value_version = "%s"
+}
+`, version)
+}
+
+func testAccSecretResourceConfig_scope(scope string) string {
+	return fmt.Sprintf(`
+provider okteto {
+	namespace = "skyscrapr"
+}
+
+resource "okteto_secret" "test" {
+  name = "test_scoped_secret"
+  value = "scoped"
+  scope = "%s"
+}
+`, scope)
+}
+
+func TestHashSecretValue(t *testing.T) {
+	hash := hashSecretValue("salt", "value")
+	if want := "salt:d430a1da30afe1a9d07b3b36042151ebaf53c4882af1609733c04930de318e33"; hash != want {
+		t.Errorf("hashSecretValue() = %q, want %q", hash, want)
+	}
+	if got := hashSalt(hash); got != "salt" {
+		t.Errorf("hashSalt() = %q, want %q", got, "salt")
+	}
+	if hashSecretValue("other", "value") == hash || hashSecretValue("salt", "other") == hash {
+		t.Error("hashSecretValue() returned the same hash for a different salt or value")
+	}
+	if got := hashSalt(""); got != "" {
+		t.Errorf("hashSalt(\"\") = %q, want an empty salt", got)
+	}
+}
+
+func TestSecretResourceModifyPlan(t *testing.T) {
+	t.Setenv("TEST_SECRET_VALUE", "value")
+	hash := hashSecretValue("salt", "value")
+
+	fromEnv := func(hash types.String) *SecretResourceModel {
+		return &SecretResourceModel{
+			Value:         types.StringNull(),
+			ValueFromEnv:  types.StringValue("TEST_SECRET_VALUE"),
+			ValueFromFile: types.StringNull(),
+			ValueVersion:  types.StringNull(),
+			ValueHash:     hash,
+			Name:          types.StringValue("TEST_SECRET"),
+			Scope:         types.StringValue(secretScopeUser),
+			Id:            types.StringValue("TEST_SECRET"),
+		}
+	}
+	changedEnv := fromEnv(types.StringValue(hash))
+	changedEnv.ValueFromEnv = types.StringValue("TEST_SECRET_OTHER_VALUE")
+	t.Setenv("TEST_SECRET_OTHER_VALUE", "other")
+	unsetEnv := fromEnv(types.StringValue(hash))
+	unsetEnv.ValueFromEnv = types.StringValue("TEST_SECRET_UNSET")
+	fromValue := fromEnv(types.StringValue(hash))
+	fromValue.ValueFromEnv = types.StringNull()
+	fromValue.Value = types.StringValue("value")
+
+	tests := []struct {
+		name  string
+		state *SecretResourceModel
+		plan  *SecretResourceModel
+		want  types.String
+	}{
+		{name: "unchanged value", state: fromEnv(types.StringValue(hash)), plan: fromEnv(types.StringValue(hash)), want: types.StringValue(hash)},
+		{name: "changed value", state: fromEnv(types.StringValue(hash)), plan: changedEnv, want: types.StringUnknown()},
+		{name: "value only available when applying", state: fromEnv(types.StringValue(hash)), plan: unsetEnv, want: types.StringValue(hash)},
+		{name: "value no longer hashed", state: fromEnv(types.StringValue(hash)), plan: fromValue, want: types.StringNull()},
+		{name: "created with a value", plan: fromValue, want: types.StringNull()},
+		{name: "created with a hashed value", plan: fromEnv(types.StringUnknown()), want: types.StringUnknown()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var state interface{}
+			if tt.state != nil {
+				state = tt.state
+			}
+			resp := testModifyPlan(t, &SecretResource{}, state, tt.plan)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() diagnostics = %v", resp.Diagnostics)
+			}
+			var got types.String
+			resp.Plan.GetAttribute(context.Background(), path.Root("value_hash"), &got)
+			if !got.Equal(tt.want) {
+				t.Errorf("ModifyPlan() value_hash = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
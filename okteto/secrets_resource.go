@@ -0,0 +1,421 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SecretsResource{}
+var _ resource.ResourceWithModifyPlan = &SecretsResource{}
+
+func NewSecretsResource() resource.Resource {
+	return &SecretsResource{}
+}
+
+// SecretsResource defines the resource implementation.
+type SecretsResource struct {
+	client *Client
+}
+
+// SecretsResourceModel describes the resource data model.
+type SecretsResourceModel struct {
+	Secrets    types.Map    `tfsdk:"secrets"`
+	FromDotenv types.String `tfsdk:"from_dotenv"`
+	Exclusive  types.Bool   `tfsdk:"exclusive"`
+	Keys       types.Set    `tfsdk:"keys"`
+	Id         types.String `tfsdk:"id"`
+}
+
+func (r *SecretsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets"
+}
+
+func (r *SecretsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages a set of secrets as a whole",
+
+		Attributes: map[string]schema.Attribute{
+			"secrets": schema.MapAttribute{
+				MarkdownDescription: "Secret values keyed by name. Takes precedence over `from_dotenv` for the same name.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(secretNameRegex, "must be a valid environment variable name: letters, digits and \"_\", not starting with a digit")),
+				},
+			},
+			"from_dotenv": schema.StringAttribute{
+				MarkdownDescription: "Contents of a `.env` file with additional secrets, such as `file(\".env\")`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"exclusive": schema.BoolAttribute{
+				MarkdownDescription: "Whether secrets not managed by this resource are deleted. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"keys": schema.SetAttribute{
+				MarkdownDescription: "Names of the secrets managed by this resource",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Secrets identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SecretsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SecretsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SecretsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.reconcile(ctx, r.client, nil)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = types.StringValue("secrets")
+	tflog.Trace(ctx, "created secrets")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecretsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SecretsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets, err := r.client.ListSecrets()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secrets, got error: %s", err))
+		return
+	}
+	remote := secretValues(secrets)
+
+	// Refresh the values of the secrets in the map and drop the ones that no longer exist
+	var values map[string]string
+	resp.Diagnostics.Append(data.Secrets.ElementsAs(ctx, &values, false)...)
+	if !data.Secrets.IsNull() {
+		refreshed := map[string]string{}
+		for name := range values {
+			if value, ok := remote[name]; ok {
+				refreshed[name] = value
+			}
+		}
+		data.Secrets, _ = types.MapValueFrom(ctx, types.StringType, refreshed)
+	}
+
+	// Only keep the keys of the managed secrets that still exist, drift of their values is detected when planning
+	var keys []string
+	resp.Diagnostics.Append(data.Keys.ElementsAs(ctx, &keys, false)...)
+	existingKeys := []string{}
+	for _, name := range keys {
+		if _, ok := remote[name]; ok {
+			existingKeys = append(existingKeys, name)
+		}
+	}
+	keysValue, diags := types.SetValueFrom(ctx, types.StringType, existingKeys)
+	resp.Diagnostics.Append(diags...)
+	data.Keys = keysValue
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecretsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *SecretsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previousKeys []string
+	resp.Diagnostics.Append(state.Keys.ElementsAs(ctx, &previousKeys, false)...)
+	resp.Diagnostics.Append(data.reconcile(ctx, r.client, previousKeys)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Trace(ctx, "updated secrets")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecretsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the secrets are destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan *SecretsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	// The secrets are only known when applying if any of them depends on another resource
+	if resp.Diagnostics.HasError() || !plan.secretsKnown() {
+		return
+	}
+
+	// The managed keys are known from the configuration, any difference with the state triggers an update
+	desired, diags := plan.desiredSecrets(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keys, diags := types.SetValueFrom(ctx, types.StringType, sortedKeys(desired))
+	resp.Diagnostics.Append(diags...)
+
+	// Secrets changed outside of Terraform, or not managed by this resource when exclusive, also trigger an update
+	if !req.State.Raw.IsNull() && r.client != nil {
+		secrets, err := r.client.ListSecrets()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secrets, got error: %s", err))
+			return
+		}
+		if secretsDrifted(desired, secretValues(secrets), plan.Exclusive.ValueBool()) {
+			keys = types.SetUnknown(types.StringType)
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("keys"), keys)...)
+}
+
+func (r *SecretsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SecretsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keys []string
+	resp.Diagnostics.Append(data.Keys.ElementsAs(ctx, &keys, false)...)
+	for _, name := range keys {
+		err := r.client.DeleteSecret(name)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete secret %s, got error: %s", name, err))
+			return
+		}
+	}
+	tflog.Trace(ctx, "deleted secrets")
+}
+
+// reconcile adds or updates the desired secrets and deletes the previously managed secrets that are no
+// longer desired, along with every other secret when exclusive. It sets the keys of the managed secrets.
+func (data *SecretsResourceModel) reconcile(ctx context.Context, client *Client, previousKeys []string) diag.Diagnostics {
+	desired, diags := data.desiredSecrets(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	secrets, err := client.ListSecrets()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read secrets, got error: %s", err))
+		return diags
+	}
+	remote := secretValues(secrets)
+
+	for _, name := range sortedKeys(desired) {
+		if value, ok := remote[name]; ok && value == desired[name] {
+			continue
+		}
+		if err := client.NewSecret(name, desired[name]); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add secret %s, got error: %s", name, err))
+			return diags
+		}
+	}
+
+	stale := previousKeys
+	if data.Exclusive.ValueBool() {
+		stale = sortedKeys(remote)
+	}
+	for _, name := range stale {
+		if _, ok := desired[name]; ok {
+			continue
+		}
+		if _, ok := remote[name]; !ok {
+			continue
+		}
+		if err := client.DeleteSecret(name); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete secret %s, got error: %s", name, err))
+			return diags
+		}
+	}
+
+	keys, keysDiags := types.SetValueFrom(ctx, types.StringType, sortedKeys(desired))
+	diags.Append(keysDiags...)
+	data.Keys = keys
+	return diags
+}
+
+// secretsKnown reports whether the names and values of every secret are known.
+func (data *SecretsResourceModel) secretsKnown() bool {
+	if data.Secrets.IsUnknown() || data.FromDotenv.IsUnknown() {
+		return false
+	}
+	for _, value := range data.Secrets.Elements() {
+		if value.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// secretsDrifted reports whether a desired secret is missing or holds another value, or when exclusive,
+// whether there are secrets that are not desired.
+func secretsDrifted(desired map[string]string, remote map[string]string, exclusive bool) bool {
+	for name, value := range desired {
+		if remoteValue, ok := remote[name]; !ok || remoteValue != value {
+			return true
+		}
+	}
+	if exclusive {
+		for name := range remote {
+			if _, ok := desired[name]; !ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// desiredSecrets returns the secrets from the .env contents, overridden by the secrets map.
+func (data *SecretsResourceModel) desiredSecrets(ctx context.Context) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	desired, err := parseDotenv(data.FromDotenv.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("from_dotenv"), "Invalid .env Contents", err.Error())
+		return nil, diags
+	}
+	for name := range desired {
+		if !secretNameRegex.MatchString(name) {
+			diags.AddAttributeError(path.Root("from_dotenv"), "Invalid Secret Name", fmt.Sprintf("%q is not a valid environment variable name", name))
+		}
+	}
+
+	var values map[string]string
+	diags.Append(data.Secrets.ElementsAs(ctx, &values, false)...)
+	for name, value := range values {
+		desired[name] = value
+	}
+	return desired, diags
+}
+
+// parseDotenv parses the contents of a .env file. Blank lines and comments are ignored, an "export"
+// prefix is allowed and values may be quoted. Escape sequences are only expanded in double quoted values.
+func parseDotenv(contents string) (map[string]string, error) {
+	values := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(contents))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		name, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected NAME=value", n)
+		}
+		name = strings.TrimSpace(name)
+		value = strings.TrimSpace(value)
+		switch {
+		case len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"':
+			value = strings.NewReplacer(`\n`, "\n", `\"`, `"`, `\\`, `\`).Replace(value[1 : len(value)-1])
+		case len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'':
+			value = value[1 : len(value)-1]
+		default:
+			// Unquoted values may be followed by a comment
+			if i := strings.Index(value, " #"); i >= 0 {
+				value = strings.TrimSpace(value[:i])
+			}
+		}
+		values[name] = value
+	}
+	return values, scanner.Err()
+}
+
+// secretValues returns the values of the secrets keyed by name.
+func secretValues(secrets []map[string]interface{}) map[string]string {
+	values := map[string]string{}
+	for _, secret := range secrets {
+		name, _ := secret["name"].(string)
+		value, _ := secret["value"].(string)
+		values[name] = value
+	}
+	return values
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
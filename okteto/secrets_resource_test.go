@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSecretsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSecretsResourceConfig("one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("okteto_secrets.test", "id"),
+					resource.TestCheckResourceAttr("okteto_secrets.test", "keys.#", "3"),
+					resource.TestCheckResourceAttr("okteto_secrets.test", "secrets.TEST_SECRETS_A", "a_one"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccSecretsResourceConfig("two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("okteto_secrets.test", "keys.#", "3"),
+					resource.TestCheckResourceAttr("okteto_secrets.test", "secrets.TEST_SECRETS_A", "a_two"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSecretsResourceConfig(suffix string) string {
+	return fmt.Sprintf(`
+provider okteto {
+	namespace = "skyscrapr"
+}
+
+resource "okteto_secrets" "test" {
+  secrets = {
+    TEST_SECRETS_A = "a_%[1]s"
+    TEST_SECRETS_B = "b_%[1]s"
+  }
+  from_dotenv = <<-EOT
+    # Loaded from .env
+    TEST_SECRETS_C="c_%[1]s"
+  EOT
+}
+`, suffix)
+}
+
+func TestParseDotenv(t *testing.T) {
+	contents := `
+# comment
+PLAIN=value
+export EXPORTED=exported
+SPACED = spaced value # trailing comment
+DOUBLE="line one\nline \"two\""
+SINGLE='literal\n # not a comment'
+EMPTY=
+`
+	want := map[string]string{
+		"PLAIN":    "value",
+		"EXPORTED": "exported",
+		"SPACED":   "spaced value",
+		"DOUBLE":   "line one\nline \"two\"",
+		"SINGLE":   `literal\n # not a comment`,
+		"EMPTY":    "",
+	}
+	got, err := parseDotenv(contents)
+	if err != nil {
+		t.Fatalf("parseDotenv() error = %s", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseDotenv() = %v, want %v", got, want)
+	}
+
+	if _, err := parseDotenv("NO_VALUE"); err == nil {
+		t.Error("parseDotenv() expected an error for a line without \"=\"")
+	}
+}
+
+func TestSecretsDrifted(t *testing.T) {
+	desired := map[string]string{"A": "1", "B": "2"}
+	tests := []struct {
+		name      string
+		remote    map[string]string
+		exclusive bool
+		want      bool
+	}{
+		{name: "in sync", remote: map[string]string{"A": "1", "B": "2"}, want: false},
+		{name: "changed value", remote: map[string]string{"A": "1", "B": "changed"}, want: true},
+		{name: "missing secret", remote: map[string]string{"A": "1"}, want: true},
+		{name: "unmanaged secret", remote: map[string]string{"A": "1", "B": "2", "C": "3"}, want: false},
+		{name: "unmanaged secret when exclusive", remote: map[string]string{"A": "1", "B": "2", "C": "3"}, exclusive: true, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := secretsDrifted(desired, tt.remote, tt.exclusive); got != tt.want {
+				t.Errorf("secretsDrifted() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSecretsResourceModifyPlan(t *testing.T) {
+	tests := []struct {
+		name     string
+		secrets  map[string]attr.Value
+		wantKeys types.Set
+	}{
+		{
+			name:     "known secrets",
+			secrets:  map[string]attr.Value{"DB": types.StringValue("password")},
+			wantKeys: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("DB")}),
+		},
+		{
+			name:     "secret only known when applying",
+			secrets:  map[string]attr.Value{"DB": types.StringUnknown()},
+			wantKeys: types.SetUnknown(types.StringType),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := &SecretsResourceModel{
+				Secrets:    types.MapValueMust(types.StringType, tt.secrets),
+				FromDotenv: types.StringNull(),
+				Exclusive:  types.BoolValue(false),
+				Keys:       types.SetUnknown(types.StringType),
+				Id:         types.StringUnknown(),
+			}
+			resp := testModifyPlan(t, &SecretsResource{}, nil, plan)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() diagnostics = %v", resp.Diagnostics)
+			}
+			var keys types.Set
+			resp.Plan.GetAttribute(context.Background(), path.Root("keys"), &keys)
+			if !keys.Equal(tt.wantKeys) {
+				t.Errorf("ModifyPlan() keys = %s, want %s", keys, tt.wantKeys)
+			}
+		})
+	}
+}
+
+// testModifyPlan runs the plan modification of the resource from the state to the plan, either of which may be nil.
+func testModifyPlan(t *testing.T, r fwresource.ResourceWithModifyPlan, state interface{}, plan interface{}) *fwresource.ModifyPlanResponse {
+	ctx := context.Background()
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	null := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+	req := fwresource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: null},
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: null},
+	}
+	if state != nil {
+		if diags := req.State.Set(ctx, state); diags.HasError() {
+			t.Fatalf("unable to set state: %v", diags)
+		}
+	}
+	if plan != nil {
+		if diags := req.Plan.Set(ctx, plan); diags.HasError() {
+			t.Fatalf("unable to set plan: %v", diags)
+		}
+	}
+
+	resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, resp)
+	return resp
+}
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserDataSource{}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+// UserDataSource defines the data source implementation.
+type UserDataSource struct {
+	client *Client
+}
+
+// UserDataSourceModel describes the data source data model.
+type UserDataSourceModel struct {
+	Id           types.String           `tfsdk:"id"`
+	Name         types.String           `tfsdk:"name"`
+	Email        types.String           `tfsdk:"email"`
+	Namespace    types.String           `tfsdk:"namespace"`
+	Plan         types.String           `tfsdk:"plan"`
+	Team         types.String           `tfsdk:"team"`
+	QuotaPlan    *userQuotaPlanModel    `tfsdk:"quota_plan"`
+	Capabilities *userCapabilitiesModel `tfsdk:"capabilities"`
+}
+
+type userQuotaPlanModel struct {
+	MaxNamespaces     types.Int64          `tfsdk:"max_namespaces"`
+	MaxPods           types.Int64          `tfsdk:"max_pods"`
+	ScaleToZeroPeriod types.Int64          `tfsdk:"scale_to_zero_period"`
+	EnableSharing     types.Bool           `tfsdk:"enable_sharing"`
+	Limits            *userQuotaLimitModel `tfsdk:"limits"`
+}
+
+type userQuotaLimitModel struct {
+	CPU     types.String `tfsdk:"cpu"`
+	Memory  types.String `tfsdk:"memory"`
+	Storage types.String `tfsdk:"storage"`
+}
+
+type userCapabilitiesModel struct {
+	MaxPersonalAccessTokens    types.Int64  `tfsdk:"max_personal_access_tokens"`
+	TeamsEnabled               types.Bool   `tfsdk:"teams_enabled"`
+	AutomaticPreviewsEnabled   types.Bool   `tfsdk:"automatic_previews_enabled"`
+	HelmCatalogEnabled         types.Bool   `tfsdk:"helm_catalog_enabled"`
+	AllowMembersShareNamespace types.Bool   `tfsdk:"allow_members_share_namespace"`
+	NamespacesPrefix           types.String `tfsdk:"namespaces_prefix"`
+	UserNamespacesSuffix       types.String `tfsdk:"user_namespaces_suffix"`
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "User the provider API token belongs to",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "User identifier",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email",
+				Computed:            true,
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Personal namespace",
+				Computed:            true,
+			},
+			"plan": schema.StringAttribute{
+				MarkdownDescription: "Plan",
+				Computed:            true,
+			},
+			"team": schema.StringAttribute{
+				MarkdownDescription: "Team",
+				Computed:            true,
+			},
+			"quota_plan": schema.SingleNestedAttribute{
+				MarkdownDescription: "Limits of the plan of the user",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"max_namespaces": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of namespaces",
+						Computed:            true,
+					},
+					"max_pods": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of pods per namespace",
+						Computed:            true,
+					},
+					"scale_to_zero_period": schema.Int64Attribute{
+						MarkdownDescription: "Period of inactivity after which namespaces are scaled to zero",
+						Computed:            true,
+					},
+					"enable_sharing": schema.BoolAttribute{
+						MarkdownDescription: "Whether namespaces can be shared",
+						Computed:            true,
+					},
+					"limits": schema.SingleNestedAttribute{
+						MarkdownDescription: "Resource limits per namespace",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"cpu": schema.StringAttribute{
+								MarkdownDescription: "CPU",
+								Computed:            true,
+							},
+							"memory": schema.StringAttribute{
+								MarkdownDescription: "Memory",
+								Computed:            true,
+							},
+							"storage": schema.StringAttribute{
+								MarkdownDescription: "Storage",
+								Computed:            true,
+							},
+						},
+					},
+				},
+			},
+			"capabilities": schema.SingleNestedAttribute{
+				MarkdownDescription: "Features enabled for the user",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"max_personal_access_tokens": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of personal access tokens",
+						Computed:            true,
+					},
+					"teams_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether teams are enabled",
+						Computed:            true,
+					},
+					"automatic_previews_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether automatic preview environments are enabled",
+						Computed:            true,
+					},
+					"helm_catalog_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the Helm catalog is enabled",
+						Computed:            true,
+					},
+					"allow_members_share_namespace": schema.BoolAttribute{
+						MarkdownDescription: "Whether members can share namespaces",
+						Computed:            true,
+					},
+					"namespaces_prefix": schema.StringAttribute{
+						MarkdownDescription: "Prefix of the namespaces created by the user",
+						Computed:            true,
+					},
+					"user_namespaces_suffix": schema.StringAttribute{
+						MarkdownDescription: "Suffix of the personal namespaces",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := d.client.GetUser()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	data.Id = stringAttr(user["id"])
+	data.Name = stringAttr(user["name"])
+	data.Email = stringAttr(user["email"])
+	data.Namespace = stringAttr(user["namespace"])
+	data.Plan = stringAttr(user["plan"])
+	data.Team = stringAttr(user["team"])
+	data.QuotaPlan = nil
+	if quotaPlan, ok := user["quotaPlan"].(map[string]interface{}); ok {
+		data.QuotaPlan = &userQuotaPlanModel{
+			MaxNamespaces:     int64Attr(quotaPlan["maxNamespaces"]),
+			MaxPods:           int64Attr(quotaPlan["maxPods"]),
+			ScaleToZeroPeriod: int64Attr(quotaPlan["scaleToZeroPeriod"]),
+			EnableSharing:     boolAttr(quotaPlan["enableSharing"]),
+		}
+		if limits, ok := quotaPlan["limits"].(map[string]interface{}); ok {
+			data.QuotaPlan.Limits = &userQuotaLimitModel{
+				CPU:     stringAttr(limits["cpu"]),
+				Memory:  stringAttr(limits["memory"]),
+				Storage: stringAttr(limits["storage"]),
+			}
+		}
+	}
+	data.Capabilities = nil
+	if capabilities, ok := user["capabilities"].(map[string]interface{}); ok {
+		data.Capabilities = &userCapabilitiesModel{
+			MaxPersonalAccessTokens:    int64Attr(capabilities["maxPersonalAccessTokens"]),
+			TeamsEnabled:               boolAttr(capabilities["teamsEnabled"]),
+			AutomaticPreviewsEnabled:   boolAttr(capabilities["automaticPreviewsEnabled"]),
+			HelmCatalogEnabled:         boolAttr(capabilities["helmCatalogEnabled"]),
+			AllowMembersShareNamespace: boolAttr(capabilities["allowMembersShareNamespace"]),
+			NamespacesPrefix:           stringAttr(capabilities["namespacesPrefix"]),
+			UserNamespacesSuffix:       stringAttr(capabilities["userNamespacesSuffix"]),
+		}
+	}
+	tflog.Trace(ctx, "read user")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccUserDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.okteto_user.test", "id"),
+					resource.TestCheckResourceAttrSet("data.okteto_user.test", "namespace"),
+					resource.TestCheckResourceAttrSet("data.okteto_user.test", "capabilities.max_personal_access_tokens"),
+				),
+			},
+		},
+	})
+}
+
+const testAccUserDataSourceConfig = `
+provider okteto {
+	namespace = "skyscrapr"
+}
+
+data "okteto_user" "test" {}
+`
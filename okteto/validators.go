@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	// pipelineNameRegex matches pipeline names whose resource name is a valid DNS label.
+	pipelineNameRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._-]*[a-zA-Z0-9])?$`)
+
+	// secretNameRegex matches valid environment variable names.
+	secretNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	// scpLikeURLRegex matches scp-like git URLs such as git@github.com:okteto/movies.git.
+	scpLikeURLRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]+@[a-zA-Z0-9.-]+:[^/].*$`)
+)
+
+var _ validator.String = repoURLValidator{}
+
+// repoURLValidator validates that a string is a https, ssh or git repository URL.
+type repoURLValidator struct{}
+
+func (v repoURLValidator) Description(ctx context.Context) string {
+	return "value must be a https, ssh or git repository URL"
+}
+
+func (v repoURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v repoURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if scpLikeURLRegex.MatchString(value) {
+		return
+	}
+
+	u, err := url.Parse(value)
+	switch {
+	case err != nil:
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Repository URL", fmt.Sprintf("Unable to parse %q, got error: %s", value, err))
+	case u.Scheme != "https" && u.Scheme != "ssh" && u.Scheme != "git":
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Repository URL", fmt.Sprintf("%q must use the https, ssh or git scheme, got %q", value, u.Scheme))
+	case u.Host == "" || strings.Trim(u.Path, "/") == "":
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Repository URL", fmt.Sprintf("%q must include a host and a repository path", value))
+	}
+}
+
+var _ validator.String = gitRefValidator{}
+
+// gitRefValidator validates that a string is a valid git branch name, following git check-ref-format.
+type gitRefValidator struct{}
+
+func (v gitRefValidator) Description(ctx context.Context) string {
+	return "value must be a valid git branch name"
+}
+
+func (v gitRefValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v gitRefValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if reason := invalidGitRefReason(value); reason != "" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Branch", fmt.Sprintf("%q is not a valid git branch name: %s", value, reason))
+	}
+}
+
+// invalidGitRefReason returns why the ref is not a valid git ref name, or an empty string if it is valid.
+func invalidGitRefReason(ref string) string {
+	switch {
+	case ref == "":
+		return "it is empty"
+	case ref == "@":
+		return "it is \"@\""
+	case strings.HasPrefix(ref, "/") || strings.HasSuffix(ref, "/"):
+		return "it begins or ends with \"/\""
+	case strings.HasSuffix(ref, "."):
+		return "it ends with \".\""
+	case strings.Contains(ref, ".."):
+		return "it contains \"..\""
+	case strings.Contains(ref, "//"):
+		return "it contains \"//\""
+	case strings.Contains(ref, "@{"):
+		return "it contains \"@{\""
+	}
+	for _, r := range ref {
+		if r < 0x20 || r == 0x7f || strings.ContainsRune(" ~^:?*[\\", r) {
+			return fmt.Sprintf("it contains %q", r)
+		}
+	}
+	for _, component := range strings.Split(ref, "/") {
+		if strings.HasPrefix(component, ".") || strings.HasSuffix(component, ".lock") {
+			return fmt.Sprintf("component %q begins with \".\" or ends with \".lock\"", component)
+		}
+	}
+	return ""
+}
+
+var _ validator.String = durationValidator{}
+
+// durationValidator validates that a string can be parsed as a duration, greater than zero when positive.
+type durationValidator struct {
+	positive bool
+}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	if v.positive {
+		return "value must be a positive duration such as \"30s\" or \"2m\""
+	}
+	return "value must be a duration such as \"30s\" or \"2m\""
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Duration", fmt.Sprintf("Unable to parse duration, got error: %s", err))
+		return
+	}
+	if v.positive && d <= 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Duration", fmt.Sprintf("Duration must be greater than zero, got: %s", req.ConfigValue.ValueString()))
+	}
+}
+
+var _ validator.String = regexValidator{}
+
+// regexValidator validates that a string is a valid regular expression.
+type regexValidator struct{}
+
+func (v regexValidator) Description(ctx context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v regexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Regular Expression", fmt.Sprintf("Unable to compile regular expression, got error: %s", err))
+	}
+}
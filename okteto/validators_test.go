@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRepoURLValidator(t *testing.T) {
+	tests := map[string]bool{
+		"https://github.com/okteto/movies.git": true,
+		"ssh://git@github.com/okteto/movies":   true,
+		"git://github.com/okteto/movies.git":   true,
+		"git@github.com:okteto/movies.git":     true,
+		"http://github.com/okteto/movies.git":  false,
+		"https://github.com":                   false,
+		"github.com/okteto/movies":             false,
+		"not a url":                            false,
+	}
+	for value, valid := range tests {
+		t.Run(value, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			repoURLValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("repo_url"),
+				ConfigValue: types.StringValue(value),
+			}, resp)
+			if got := !resp.Diagnostics.HasError(); got != valid {
+				t.Errorf("repoURLValidator(%q) valid = %t, want %t: %v", value, got, valid, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestInvalidGitRefReason(t *testing.T) {
+	tests := map[string]bool{
+		"main":              true,
+		"feature/new-api":   true,
+		"release-1.2":       true,
+		"":                  false,
+		"@":                 false,
+		"feature/":          false,
+		"/feature":          false,
+		"feature//api":      false,
+		"feature..api":      false,
+		"feature.":          false,
+		"feature/.hidden":   false,
+		"feature.lock":      false,
+		"feature@{1}":       false,
+		"feature branch":    false,
+		"feature~1":         false,
+		"feature:api":       false,
+		"feature\\api":      false,
+		"feature\x7fdelete": false,
+	}
+	for ref, valid := range tests {
+		t.Run(ref, func(t *testing.T) {
+			if reason := invalidGitRefReason(ref); (reason == "") != valid {
+				t.Errorf("invalidGitRefReason(%q) = %q, want valid = %t", ref, reason, valid)
+			}
+		})
+	}
+}
+
+func TestNameRegexes(t *testing.T) {
+	for name, valid := range map[string]bool{"okteto_aws_lambda": true, "api.v2": true, "MyApp": true, "-api": false, "api_": false, "my app": false} {
+		if got := pipelineNameRegex.MatchString(name); got != valid {
+			t.Errorf("pipelineNameRegex.MatchString(%q) = %t, want %t", name, got, valid)
+		}
+	}
+	for name, valid := range map[string]bool{"AWS_REGION": true, "_private": true, "aws1": true, "1AWS": false, "AWS-REGION": false, "": false} {
+		if got := secretNameRegex.MatchString(name); got != valid {
+			t.Errorf("secretNameRegex.MatchString(%q) = %t, want %t", name, got, valid)
+		}
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	tests := []struct {
+		value    string
+		positive bool
+		valid    bool
+	}{
+		{value: "30s", valid: true},
+		{value: "-1h", valid: true},
+		{value: "0s", valid: true},
+		{value: "soon", valid: false},
+		{value: "720h", positive: true, valid: true},
+		{value: "-1h", positive: true, valid: false},
+		{value: "0s", positive: true, valid: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			durationValidator{positive: tt.positive}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("ttl"),
+				ConfigValue: types.StringValue(tt.value),
+			}, resp)
+			if got := !resp.Diagnostics.HasError(); got != tt.valid {
+				t.Errorf("durationValidator{positive: %t}(%q) valid = %t, want %t: %v", tt.positive, tt.value, got, tt.valid, resp.Diagnostics)
+			}
+		})
+	}
+}
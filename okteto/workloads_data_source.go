@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WorkloadsDataSource{}
+
+func NewWorkloadsDataSource() datasource.DataSource {
+	return &WorkloadsDataSource{}
+}
+
+// WorkloadsDataSource defines the data source implementation.
+type WorkloadsDataSource struct {
+	client *Client
+}
+
+// WorkloadsDataSourceModel describes the data source data model.
+type WorkloadsDataSourceModel struct {
+	Namespace  types.String    `tfsdk:"namespace"`
+	Kinds      []string        `tfsdk:"kinds"`
+	DeployedBy types.String    `tfsdk:"deployed_by"`
+	Status     types.String    `tfsdk:"status"`
+	Workloads  []workloadModel `tfsdk:"workloads"`
+	Id         types.String    `tfsdk:"id"`
+}
+
+type workloadModel struct {
+	Kind       types.String  `tfsdk:"kind"`
+	Id         types.String  `tfsdk:"id"`
+	Name       types.String  `tfsdk:"name"`
+	DeployedBy types.String  `tfsdk:"deployed_by"`
+	Status     types.String  `tfsdk:"status"`
+	Error      types.String  `tfsdk:"error"`
+	Replicas   types.Int64   `tfsdk:"replicas"`
+	NumPods    types.Int64   `tfsdk:"num_pods"`
+	CPU        types.Float64 `tfsdk:"cpu"`
+	Memory     types.Float64 `tfsdk:"memory"`
+	Storage    types.Float64 `tfsdk:"storage"`
+	CreatedAt  types.String  `tfsdk:"created_at"`
+	UpdatedAt  types.String  `tfsdk:"updated_at"`
+}
+
+func (d *WorkloadsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workloads"
+}
+
+func (d *WorkloadsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Inventory of the workloads of a namespace",
+
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace. Defaults to the provider namespace.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"kinds": schema.SetAttribute{
+				MarkdownDescription: "Only return workloads of these kinds. Valid values are `deployments`, `statefulsets`, `jobs`, `cronjobs`, `functions`, `pods` and `volumes`. Defaults to all of them.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(InventoryKinds...)),
+				},
+			},
+			"deployed_by": schema.StringAttribute{
+				MarkdownDescription: "Only return workloads deployed by this pipeline, identified by the resource name Okteto derives from the pipeline name",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return workloads with this status",
+				Optional:            true,
+			},
+			"workloads": schema.ListNestedAttribute{
+				MarkdownDescription: "Workloads",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Kind",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Workload identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name",
+							Computed:            true,
+						},
+						"deployed_by": schema.StringAttribute{
+							MarkdownDescription: "Resource name of the pipeline that deployed the workload",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status",
+							Computed:            true,
+						},
+						"error": schema.StringAttribute{
+							MarkdownDescription: "Error",
+							Computed:            true,
+						},
+						"replicas": schema.Int64Attribute{
+							MarkdownDescription: "Replicas",
+							Computed:            true,
+						},
+						"num_pods": schema.Int64Attribute{
+							MarkdownDescription: "Number of pods",
+							Computed:            true,
+						},
+						"cpu": schema.Float64Attribute{
+							MarkdownDescription: "CPU used, in cores",
+							Computed:            true,
+						},
+						"memory": schema.Float64Attribute{
+							MarkdownDescription: "Memory used, in bytes",
+							Computed:            true,
+						},
+						"storage": schema.Float64Attribute{
+							MarkdownDescription: "Storage used by volumes, in bytes",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Creation date",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Date of the last update",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *WorkloadsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WorkloadsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkloadsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace := d.client.Namespace
+	if !data.Namespace.IsNull() {
+		namespace = data.Namespace.ValueString()
+	}
+	// List the kinds in a stable order, whatever the order of the configuration set
+	kinds := []string{}
+	for _, kind := range InventoryKinds {
+		if data.Kinds == nil || containsString(data.Kinds, kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	workloads, err := d.client.ListWorkloads(namespace, kinds, data.DeployedBy.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workloads, got error: %s", err))
+		return
+	}
+
+	data.Namespace = types.StringValue(namespace)
+	data.Kinds = kinds
+	data.Workloads = flattenWorkloads(workloads, kinds, data.Status.ValueString())
+	data.Id = types.StringValue(namespace)
+	tflog.Trace(ctx, "read workloads")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenWorkloads returns the workloads, keyed by kind, in the order of kinds.
+// Only the workloads with the given status are returned, unless it is empty.
+func flattenWorkloads(workloads map[string][]map[string]interface{}, kinds []string, status string) []workloadModel {
+	flattened := []workloadModel{}
+	for _, kind := range kinds {
+		for _, workload := range workloads[kind] {
+			if workloadStatus, _ := workload["status"].(string); status != "" && workloadStatus != status {
+				continue
+			}
+			flattened = append(flattened, workloadModel{
+				Kind:       types.StringValue(kind),
+				Id:         stringAttr(workload["id"]),
+				Name:       stringAttr(workload["name"]),
+				DeployedBy: stringAttr(workload["deployedBy"]),
+				Status:     stringAttr(workload["status"]),
+				Error:      stringAttr(workload["error"]),
+				Replicas:   int64Attr(workload["replicas"]),
+				NumPods:    int64Attr(workload["numPods"]),
+				CPU:        usedQuota(workload["cpu"]),
+				Memory:     usedQuota(workload["memory"]),
+				Storage:    usedQuota(workload["storage"]),
+				CreatedAt:  stringAttr(workload["createdAt"]),
+				UpdatedAt:  stringAttr(workload["updatedAt"]),
+			})
+		}
+	}
+	return flattened
+}
+
+// usedQuota returns the used value of a resource usage field, null when it is not set.
+func usedQuota(v interface{}) types.Float64 {
+	quota, _ := v.(map[string]interface{})
+	return float64Attr(quota["used"])
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package okteto
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWorkloadsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccWorkloadsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okteto_workloads.test", "namespace", "skyscrapr"),
+					resource.TestCheckResourceAttr("data.okteto_workloads.test", "kinds.#", "2"),
+					resource.TestCheckResourceAttr("data.okteto_workloads.test", "deployed_by", "okteto-aws-lambda"),
+				),
+			},
+		},
+	})
+}
+
+const testAccWorkloadsDataSourceConfig = `
+provider okteto {
+	namespace = "skyscrapr"
+}
+
+resource "okteto_pipeline" "test" {
+  name = "okteto_aws_lambda"
+  repo_url = "https://github.com/skyscrapr/okteto-pipeline-test.git"
+  branch = "main"
+}
+
+data "okteto_workloads" "test" {
+  kinds = ["pods", "cronjobs"]
+  deployed_by = "okteto-aws-lambda"
+
+  depends_on = [okteto_pipeline.test]
+}
+`
+
+func TestFlattenWorkloads(t *testing.T) {
+	workloads := map[string][]map[string]interface{}{
+		"pods": {
+			{"name": "api-1", "status": "running", "cpu": map[string]interface{}{"used": 0.25}},
+			{"name": "api-2", "status": "error", "error": "CrashLoopBackOff"},
+		},
+		"volumes": {
+			{"name": "data", "status": "running", "storage": map[string]interface{}{"used": 1024.0}},
+		},
+	}
+
+	flattened := flattenWorkloads(workloads, []string{"pods", "volumes"}, "")
+	if len(flattened) != 3 {
+		t.Fatalf("flattenWorkloads() returned %d workloads, want 3", len(flattened))
+	}
+	if got := flattened[0]; got.Kind.ValueString() != "pods" || got.CPU.ValueFloat64() != 0.25 || !got.Memory.IsNull() {
+		t.Errorf("flattenWorkloads()[0] = %+v, want pod api-1 using 0.25 cores and no memory usage", got)
+	}
+	if got := flattened[2]; got.Kind.ValueString() != "volumes" || got.Storage.ValueFloat64() != 1024 {
+		t.Errorf("flattenWorkloads()[2] = %+v, want volume data using 1024 bytes", got)
+	}
+
+	flattened = flattenWorkloads(workloads, []string{"pods"}, "error")
+	if len(flattened) != 1 || flattened[0].Error.ValueString() != "CrashLoopBackOff" {
+		t.Errorf("flattenWorkloads() filtered by status = %+v, want pod api-2", flattened)
+	}
+}